@@ -0,0 +1,17 @@
+CREATE TABLE projects (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    color VARCHAR(7) NOT NULL DEFAULT '',
+    archived BOOLEAN NOT NULL DEFAULT false,
+    position INT NOT NULL DEFAULT 0,  -- Urutan project milik user
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_projects_user_position ON projects (user_id, position);
+
+-- Task bisa dikelompokkan ke dalam project, task tetap ada ketika project dihapus
+ALTER TABLE tasks ADD COLUMN project_id INT NULL REFERENCES projects(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_tasks_project ON tasks (project_id);
@@ -0,0 +1,14 @@
+-- Lampiran file pada task, isi file disimpan di blob store dengan key storage_key
+CREATE TABLE task_attachments (
+    id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,  -- Pengunggah, kuota dihitung per user ini
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes >= 0),
+    storage_key TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_task_attachments_task_id ON task_attachments (task_id, id);
+CREATE INDEX idx_task_attachments_user_id ON task_attachments (user_id);
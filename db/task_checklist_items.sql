@@ -0,0 +1,14 @@
+CREATE TABLE task_checklist_items (
+    id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    title VARCHAR(255) NOT NULL,
+    is_done BOOLEAN NOT NULL DEFAULT false,
+    position INT NOT NULL DEFAULT 0,  -- Urutan item di dalam task
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_task_checklist_items_task ON task_checklist_items (task_id, position);
+
+-- Selesaikan task otomatis jika semua checklist selesai
+ALTER TABLE tasks ADD COLUMN auto_finish BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,10 @@
+-- Ketergantungan antar task, task_id tidak bisa diselesaikan sebelum depends_on_id selesai
+CREATE TABLE task_dependencies (
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    depends_on_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (task_id, depends_on_id),
+    CHECK (task_id <> depends_on_id)
+);
+
+CREATE INDEX idx_task_dependencies_depends_on_id ON task_dependencies (depends_on_id);
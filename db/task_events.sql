@@ -0,0 +1,14 @@
+-- Riwayat perubahan task, ditulis oleh repository di transaksi yang sama dengan perubahannya
+CREATE TABLE task_events (
+    id BIGSERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    actor_id INT REFERENCES users(id) ON DELETE SET NULL,  -- NULL berarti perubahan oleh sistem, contoh expire
+    action VARCHAR(20) NOT NULL CHECK (action IN ('create', 'update', 'finish', 'expire', 'delete', 'restore')),
+    old_values JSONB,  -- Hanya field yang berubah
+    new_values JSONB,
+    request_id VARCHAR(128),  -- X-Request-ID dari request HTTP yang memicu perubahan
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_task_events_task_id ON task_events (task_id, id);
+CREATE INDEX idx_task_events_request_id ON task_events (request_id) WHERE request_id IS NOT NULL;
@@ -0,0 +1,20 @@
+-- Template untuk task berulang, setiap instance adalah baris di tabel tasks
+CREATE TABLE task_recurrences (
+    id SERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    rrule VARCHAR(255) NOT NULL,  -- RRULE RFC 5545, contoh FREQ=WEEKLY;BYDAY=MO
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',  -- Zona waktu untuk menghitung expires_at instance berikutnya
+    dtstart TIMESTAMP NOT NULL,  -- expires_at instance pertama
+    title VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    priority VARCHAR(10) NOT NULL CHECK (priority IN ('low', 'medium', 'high', 'urgent')) DEFAULT 'medium',
+    notes TEXT NOT NULL DEFAULT '',
+    auto_finish BOOLEAN NOT NULL DEFAULT false,
+    project_id INT NULL REFERENCES projects(id) ON DELETE SET NULL,
+    active BOOLEAN NOT NULL DEFAULT true,  -- false jika seri sudah dihentikan
+    last_task_id INT NULL,  -- Instance terbaru, hanya instance ini yang bisa membuat instance berikutnya
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE tasks ADD COLUMN recurrence_id INT NULL REFERENCES task_recurrences(id) ON DELETE SET NULL;
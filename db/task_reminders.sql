@@ -0,0 +1,14 @@
+-- Pengingat sebelum task expired, sent_at menandai pengingat yang sudah dikirim.
+-- claimed_at adalah lease scheduler, klaim yang tidak ditandai terkirim sebelum lease habis diambil lagi
+CREATE TABLE task_reminders (
+    id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    offset_seconds INT NOT NULL CHECK (offset_seconds > 0),  -- Jarak pengingat sebelum expires_at
+    remind_at TIMESTAMP NOT NULL,  -- expires_at dikurangi offset_seconds
+    claimed_at TIMESTAMP NULL,  -- Waktu pengingat diambil scheduler dan sedang dikirim
+    sent_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (task_id, offset_seconds)
+);
+
+CREATE INDEX idx_task_reminders_due ON task_reminders (remind_at) WHERE sent_at IS NULL;
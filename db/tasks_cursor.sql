@@ -0,0 +1,2 @@
+-- Index untuk daftar task per user dan keyset pagination (expires_at, id)
+CREATE INDEX idx_tasks_user_expires_id ON tasks (user_id, expires_at, id);
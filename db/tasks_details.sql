@@ -0,0 +1,16 @@
+-- Detail task, jalankan setelah tasks_search.sql
+ALTER TABLE tasks
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',  -- Deskripsi task dalam format markdown
+    ADD COLUMN priority VARCHAR(10) NOT NULL CHECK (priority IN ('low', 'medium', 'high', 'urgent')) DEFAULT 'medium',
+    ADD COLUMN notes TEXT NOT NULL DEFAULT '';
+
+-- Full-text search juga mencakup description, kolom generated harus dibuat ulang
+-- sehingga index-nya ikut dibuat ulang
+ALTER TABLE tasks DROP COLUMN search_vector;
+ALTER TABLE tasks ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce(description, '')), 'B')
+) STORED;
+
+-- Index untuk full-text search pada title dan description
+CREATE INDEX idx_tasks_search_vector ON tasks USING GIN (search_vector);
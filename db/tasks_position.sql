@@ -0,0 +1,5 @@
+-- Rank urutan manual per pemilik (fractional indexing), NULL berarti belum diurutkan dan tampil di akhir
+ALTER TABLE tasks ADD COLUMN position VARCHAR(64) COLLATE "C" NULL;
+
+-- Index untuk urutan manual (sort=manual, dikelompokkan per pemilik) dan pencarian tetangga saat task dipindahkan
+CREATE INDEX idx_tasks_user_position_id ON tasks (user_id, position, id);
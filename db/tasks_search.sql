@@ -0,0 +1,4 @@
+-- Full-text search pada title task
+ALTER TABLE tasks ADD COLUMN search_vector TSVECTOR GENERATED ALWAYS AS (to_tsvector('simple', coalesce(title, ''))) STORED;
+
+CREATE INDEX idx_tasks_search_vector ON tasks USING GIN (search_vector);
@@ -0,0 +1,5 @@
+-- Snooze task, jalankan setelah tasks_status.sql
+ALTER TABLE tasks ADD COLUMN snooze_count INT NOT NULL DEFAULT 0;  -- Berapa kali expires_at diundur lewat snooze
+
+ALTER TABLE task_events DROP CONSTRAINT task_events_action_check,
+    ADD CONSTRAINT task_events_action_check CHECK (action IN ('create', 'update', 'start', 'finish', 'cancel', 'reopen', 'expire', 'snooze', 'delete', 'restore'));
@@ -0,0 +1,6 @@
+-- State machine task, perpindahan status diatur use case task. Jalankan setelah task_events.sql
+ALTER TABLE tasks DROP CONSTRAINT tasks_status_check,
+    ADD CONSTRAINT tasks_status_check CHECK (status IN ('pending', 'in_progress', 'done', 'expired', 'cancelled'));
+
+ALTER TABLE task_events DROP CONSTRAINT task_events_action_check,
+    ADD CONSTRAINT task_events_action_check CHECK (action IN ('create', 'update', 'start', 'finish', 'cancel', 'reopen', 'expire', 'delete', 'restore'));
@@ -0,0 +1,2 @@
+-- Soft delete task, task yang dihapus masuk ke trash dan bisa dikembalikan
+ALTER TABLE tasks ADD COLUMN deleted_at TIMESTAMP NULL;  -- Diisi ketika task dihapus (soft delete)
@@ -0,0 +1,16 @@
+-- Catatan waktu pengerjaan task, stopped_at NULL berarti timer masih berjalan
+CREATE TABLE time_entries (
+    id SERIAL PRIMARY KEY,
+    task_id INT NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,  -- User yang mencatat waktu, bisa kolaborator task
+    started_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    stopped_at TIMESTAMP NULL,
+    note TEXT NOT NULL DEFAULT '',
+    CHECK (stopped_at IS NULL OR stopped_at >= started_at)
+);
+
+-- Setiap user hanya boleh memiliki satu timer yang berjalan
+CREATE UNIQUE INDEX idx_time_entries_running ON time_entries (user_id) WHERE stopped_at IS NULL;
+
+CREATE INDEX idx_time_entries_task_id ON time_entries (task_id);
+CREATE INDEX idx_time_entries_user_started ON time_entries (user_id, started_at);
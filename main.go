@@ -9,15 +9,24 @@ import (
 
 	"todo_list/src/infra/config"
 
+	"todo_list/src/infra/persistence/blob"
 	postgres "todo_list/src/infra/persistence/postgres"
+	redis "todo_list/src/infra/persistence/redis"
 
+	taskDto "todo_list/src/app/dto/task"
+	projectRepo "todo_list/src/app/repositories/project"
+	tagRepo "todo_list/src/app/repositories/tag"
 	taskRepo "todo_list/src/app/repositories/task"
 	userRepo "todo_list/src/app/repositories/user"
 
+	"todo_list/src/interface/consumer"
 	"todo_list/src/interface/rest"
+	"todo_list/src/interface/worker"
 
 	ms_log "todo_list/src/infra/log"
 
+	projectUC "todo_list/src/app/usecases/project"
+	tagUC "todo_list/src/app/usecases/tag"
 	taskUC "todo_list/src/app/usecases/task"
 	userUC "todo_list/src/app/usecases/user"
 
@@ -27,7 +36,9 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"todo_list/src/infra/broker/nats"
+	natsConsumer "todo_list/src/infra/broker/nats/consumer"
 	natsPublisher "todo_list/src/infra/broker/nats/publisher"
+	Const "todo_list/src/infra/constants"
 )
 
 func main() {
@@ -37,8 +48,9 @@ func main() {
 		log.Fatalf("Error loading .env file")
 	}
 
-	// Initialize a new context
-	ctx := context.Background()
+	// Initialize a new context, cancelled when the application exits
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	// Read configuration from environment variables
 	conf := config.Make()
@@ -74,24 +86,71 @@ func main() {
 		}
 	}(logger, postgresdb.Conn.DB, postgresdb.Conn.DriverName())
 
-	// Initialize repositories for user and task management
+	// Initialize repositories for user, task, tag and project management
 	userRepository := userRepo.NewUserRepository(postgresdb.Conn)
 	taskRepository := taskRepo.NewTaskRepository(postgresdb.Conn)
+	tagRepository := tagRepo.NewTagRepository(postgresdb.Conn)
+	projectRepository := projectRepo.NewProjectRepository(postgresdb.Conn)
 
 	// Initialize NATS message broker
 	Nats := nats.NewNats(conf.Nats, logger)
 	// Initialize NATS publisher
 	publisher := natsPublisher.NewPushWorker(Nats)
 
+	// Initialize Redis, optional and only used for task expiry tracking
+	redisClient := redis.New(conf.Redis, logger)
+	defer func(l *logrus.Logger, r *redis.Redis) {
+		if err := r.Close(); err != nil {
+			l.Errorf("error closing redis: %s", err)
+		}
+	}(logger, redisClient)
+	taskExpiry := redis.NewTaskExpiry(redisClient, logger)
+
+	// Initialize blob store for task attachments
+	blobStore, err := blob.New(conf.Blob)
+	if err != nil {
+		logger.Fatalf("Failed to initialize blob store: %s", err)
+	}
+	attachmentLimits := blob.NewLimits(conf.Blob)
+
+	// Initialize use cases
+	allUseCases := usecases.AllUseCases{
+		UserUC:    userUC.NewUserUseCase(userRepository),                                                     // User use case
+		TaskUC:    taskUC.NewTaskUseCase(publisher, taskRepository, taskExpiry, blobStore, attachmentLimits), // Task use case
+		TagUC:     tagUC.NewTagUseCase(tagRepository),                                                        // Tag use case
+		ProjectUC: projectUC.NewProjectUseCase(projectRepository),                                            // Project use case
+	}
+
+	// Listen to Redis expired keys and move the task to expired status
+	if taskExpiry.Active() {
+		go taskExpiry.Listen(ctx, func(taskID int64) error {
+			return allUseCases.TaskUC.ExpireTask(&taskDto.ExpireTaskReqDTO{ID: taskID})
+		})
+	}
+
+	// Initialize NATS consumer to persist task events
+	taskConsumer, err := consumer.New(
+		natsConsumer.NewSubscribeWorker(Nats, Const.TASK_QUEUE, logger),
+		logger,
+		allUseCases,
+	)
+	if err != nil {
+		logger.Errorf("Failed to start NATS consumer: %s", err)
+	} else {
+		defer taskConsumer.Stop()
+	}
+
+	// Initialize scheduled jobs, stopped after the HTTP server shuts down
+	jobs := worker.New(conf.Scheduler, taskExpiry.Active(), logger, allUseCases)
+	jobs.Start(ctx)
+	defer jobs.Stop()
+
 	// Initialize HTTP server with use cases
 	httpServer, err := rest.New(
 		conf.Http,
 		isProd,
 		logger,
-		usecases.AllUseCases{
-			UserUC: userUC.NewUserUseCase(userRepository), // User use case
-			TaskUC: taskUC.NewTaskUseCase(publisher, taskRepository), // Task use case
-		},
+		allUseCases,
 	)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,89 @@
+package blob
+
+import (
+	"io"
+	blob "todo_list/src/infra/persistence/blob"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockBlobStore struct {
+	mock.Mock
+}
+
+func NewMockBlobStore() *MockBlobStore {
+	return &MockBlobStore{}
+}
+
+var _ blob.BlobStore = &MockBlobStore{}
+
+func (o *MockBlobStore) Put(key string, r io.Reader) (int64, error) {
+	args := o.Called(key, r)
+
+	var (
+		size int64
+		err  error
+	)
+
+	// Habiskan isi reader seperti penyimpanan sungguhan
+	if r != nil {
+		io.Copy(io.Discard, r)
+	}
+
+	if n, ok := args.Get(0).(int64); ok {
+		size = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return size, err
+}
+
+func (o *MockBlobStore) Open(key string) (io.ReadCloser, error) {
+	args := o.Called(key)
+
+	var (
+		resp io.ReadCloser
+		err  error
+	)
+
+	if n, ok := args.Get(0).(io.ReadCloser); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockBlobStore) Delete(key string) error {
+	args := o.Called(key)
+
+	var (
+		err error
+	)
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockBlobStore) DeletePrefix(prefix string) error {
+	args := o.Called(prefix)
+
+	var (
+		err error
+	)
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"context"
+	"time"
+	redis "todo_list/src/infra/persistence/redis"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTaskExpiry struct {
+	mock.Mock
+}
+
+func NewMockTaskExpiry() *MockTaskExpiry {
+	return &MockTaskExpiry{}
+}
+
+var _ redis.TaskExpiryInterface = &MockTaskExpiry{}
+
+func (o *MockTaskExpiry) Active() bool {
+	args := o.Called()
+
+	return args.Bool(0)
+}
+
+func (o *MockTaskExpiry) Schedule(taskID int64, expiresAt time.Time) error {
+	args := o.Called(taskID, expiresAt)
+
+	var (
+		err error
+	)
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTaskExpiry) Cancel(taskID int64) error {
+	args := o.Called(taskID)
+
+	var (
+		err error
+	)
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTaskExpiry) Listen(ctx context.Context, handler func(taskID int64) error) {
+	o.Called(ctx, handler)
+}
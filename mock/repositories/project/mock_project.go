@@ -0,0 +1,162 @@
+package project
+
+import (
+	dto "todo_list/src/app/dto/project"
+	shareDto "todo_list/src/app/dto/share"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockProject struct {
+	mock.Mock
+}
+
+func (o *MockProject) GetProjectList(req *dto.GetProjectListReqDTO) ([]*dto.ProjectRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*dto.ProjectRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.ProjectRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockProject) GetProject(req *dto.GetProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.ProjectRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.ProjectRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockProject) AddProject(req *dto.CreateProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.ProjectRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.ProjectRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockProject) UpdateProject(req *dto.UpdateProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.ProjectRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.ProjectRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockProject) DeleteProject(req *dto.DeleteProjectReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockProject) ReorderProject(req *dto.ReorderProjectReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockProject) ShareProject(req *shareDto.ShareReqDTO) (*shareDto.ShareRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *shareDto.ShareRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*shareDto.ShareRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockProject) GetProjectShares(req *shareDto.GetShareListReqDTO) ([]*shareDto.ShareRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*shareDto.ShareRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*shareDto.ShareRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockProject) RemoveProjectShare(req *shareDto.RemoveShareReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
@@ -0,0 +1,80 @@
+package tag
+
+import (
+	dto "todo_list/src/app/dto/tag"
+
+	"github.com/stretchr/testify/mock"
+)
+
+type MockTag struct {
+	mock.Mock
+}
+
+func (o *MockTag) GetTagList(req *dto.GetTagListReqDTO) ([]*dto.TagRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*dto.TagRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.TagRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTag) AddTag(req *dto.CreateTagReqDTO) (*dto.TagRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.TagRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TagRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTag) UpdateTag(req *dto.UpdateTagReqDTO) (*dto.TagRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.TagRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TagRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTag) DeleteTag(req *dto.DeleteTagReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
@@ -1,6 +1,8 @@
 package task
 
 import (
+	"time"
+	shareDto "todo_list/src/app/dto/share"
 	dto "todo_list/src/app/dto/task"
 	repo "todo_list/src/app/repositories/task"
 
@@ -17,7 +19,178 @@ func NewMockTask() *MockTask {
 
 var _ repo.TaskRepository = &MockTask{}
 
-func (o *MockTask) GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error) {
+func (o *MockTask) GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, int64, error) {
+	args := o.Called(req)
+
+	var (
+		resp  []*dto.GetTaskRespDTO
+		total int64
+		err   error
+	)
+
+	if n, ok := args.Get(0).([]*dto.GetTaskRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(int64); ok {
+		total = n
+	}
+
+	if n, ok := args.Get(2).(error); ok {
+		err = n
+	}
+
+	return resp, total, err
+}
+
+func (o *MockTask) AddTask(req *dto.CreateTaskReqDTO) (int64, error) {
+	args := o.Called(req)
+
+	var (
+		id  int64
+		err error
+	)
+
+	if n, ok := args.Get(0).(int64); ok {
+		id = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return id, err
+}
+
+func (o *MockTask) FinishTask(req *dto.FinishtTaskReqDTO) (*dto.TaskTransitionEventDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.TaskTransitionEventDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TaskTransitionEventDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) ExpireTask(req *dto.ExpireTaskReqDTO) (*dto.TaskExpiredEventDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.TaskExpiredEventDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TaskExpiredEventDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) ExpireOverdueTasks(limit int) ([]*dto.TaskExpiredEventDTO, error) {
+	args := o.Called(limit)
+
+	var (
+		resp []*dto.TaskExpiredEventDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.TaskExpiredEventDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetTask(req *dto.GetTaskByIDReqDTO) (*dto.GetTaskRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.GetTaskRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.GetTaskRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) UpdateTask(req *dto.UpdateTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.GetTaskRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.GetTaskRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) DeleteTask(req *dto.DeleteTaskReqDTO) error {
+	args := o.Called(req)
+
+	var (
+		err error
+	)
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) RestoreTask(req *dto.RestoreTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.GetTaskRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.GetTaskRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetTrashList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error) {
 	args := o.Called(req)
 
 	var (
@@ -35,3 +208,687 @@ func (o *MockTask) GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, e
 
 	return resp, err
 }
+
+func (o *MockTask) PurgeTrash(deletedBefore time.Time) ([]int64, error) {
+	args := o.Called(deletedBefore)
+
+	var (
+		ids []int64
+		err error
+	)
+
+	if n, ok := args.Get(0).([]int64); ok {
+		ids = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return ids, err
+}
+
+func (o *MockTask) SearchTask(req *dto.SearchTaskReqDTO) ([]*dto.SearchTaskRespDTO, int64, error) {
+	args := o.Called(req)
+
+	var (
+		resp  []*dto.SearchTaskRespDTO
+		total int64
+		err   error
+	)
+
+	if n, ok := args.Get(0).([]*dto.SearchTaskRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(int64); ok {
+		total = n
+	}
+
+	if n, ok := args.Get(2).(error); ok {
+		err = n
+	}
+
+	return resp, total, err
+}
+
+func (o *MockTask) GetChecklist(req *dto.GetChecklistReqDTO) ([]*dto.ChecklistItemRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*dto.ChecklistItemRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.ChecklistItemRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) AddChecklistItem(req *dto.AddChecklistItemReqDTO) (*dto.ChecklistItemRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.ChecklistItemRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.ChecklistItemRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) CheckChecklistItem(req *dto.CheckChecklistItemReqDTO) (*dto.ChecklistSummaryDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.ChecklistSummaryDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.ChecklistSummaryDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) ReorderChecklist(req *dto.ReorderChecklistReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) DeleteChecklistItem(req *dto.DeleteChecklistItemReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) ProjectExists(projectID int64, userID int64) error {
+	args := o.Called(projectID, userID)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) GetTaskRecurrence(taskID int64) (*dto.TaskRecurrenceDTO, error) {
+	args := o.Called(taskID)
+
+	var (
+		resp *dto.TaskRecurrenceDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TaskRecurrenceDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) AddNextInstance(req *dto.NextInstanceReqDTO) (int64, error) {
+	args := o.Called(req)
+
+	var (
+		id  int64
+		err error
+	)
+
+	if n, ok := args.Get(0).(int64); ok {
+		id = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return id, err
+}
+
+func (o *MockTask) GetRecurrence(req *dto.GetRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.RecurrenceRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.RecurrenceRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) UpdateRecurrence(req *dto.UpdateRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.RecurrenceRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.RecurrenceRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) StopRecurrence(req *dto.StopRecurrenceReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) ClaimDueReminders(limit int) ([]*dto.TaskReminderEventDTO, error) {
+	args := o.Called(limit)
+
+	var (
+		resp []*dto.TaskReminderEventDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.TaskReminderEventDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) MarkReminderSent(id int64) error {
+	args := o.Called(id)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) ReleaseReminder(id int64) error {
+	args := o.Called(id)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) AddDependency(req *dto.AddDependencyReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) RemoveDependency(req *dto.RemoveDependencyReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) ShareTask(req *shareDto.ShareReqDTO) (*shareDto.ShareRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *shareDto.ShareRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*shareDto.ShareRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetTaskShares(req *shareDto.GetShareListReqDTO) ([]*shareDto.ShareRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*shareDto.ShareRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*shareDto.ShareRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) RemoveTaskShare(req *shareDto.RemoveShareReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) ProjectVisible(projectID int64, userID int64) error {
+	args := o.Called(projectID, userID)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) AddComment(req *dto.AddCommentReqDTO) (*dto.CommentRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.CommentRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.CommentRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetCommentList(req *dto.GetCommentListReqDTO) ([]*dto.CommentRespDTO, int64, error) {
+	args := o.Called(req)
+
+	var (
+		resp  []*dto.CommentRespDTO
+		total int64
+		err   error
+	)
+
+	if n, ok := args.Get(0).([]*dto.CommentRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(int64); ok {
+		total = n
+	}
+
+	if n, ok := args.Get(2).(error); ok {
+		err = n
+	}
+
+	return resp, total, err
+}
+
+func (o *MockTask) UpdateComment(req *dto.UpdateCommentReqDTO) (*dto.CommentRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.CommentRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.CommentRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) DeleteComment(req *dto.DeleteCommentReqDTO) error {
+	args := o.Called(req)
+
+	var err error
+
+	if n, ok := args.Get(0).(error); ok {
+		err = n
+	}
+
+	return err
+}
+
+func (o *MockTask) GetAttachmentUsage(userID int64) (int64, error) {
+	args := o.Called(userID)
+
+	var (
+		used int64
+		err  error
+	)
+
+	if n, ok := args.Get(0).(int64); ok {
+		used = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return used, err
+}
+
+func (o *MockTask) AddAttachment(req *dto.AddAttachmentReqDTO, quota int64) (*dto.AttachmentRespDTO, error) {
+	args := o.Called(req, quota)
+
+	var (
+		resp *dto.AttachmentRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.AttachmentRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetAttachmentList(req *dto.GetAttachmentListReqDTO) ([]*dto.AttachmentRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*dto.AttachmentRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.AttachmentRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetAttachment(req *dto.GetAttachmentReqDTO) (*dto.AttachmentRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.AttachmentRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.AttachmentRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) DeleteAttachment(req *dto.DeleteAttachmentReqDTO) (*dto.AttachmentRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.AttachmentRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.AttachmentRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetTaskHistory(req *dto.GetTaskHistoryReqDTO) ([]*dto.TaskEventRespDTO, int64, error) {
+	args := o.Called(req)
+
+	var (
+		resp  []*dto.TaskEventRespDTO
+		total int64
+		err   error
+	)
+
+	if n, ok := args.Get(0).([]*dto.TaskEventRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(int64); ok {
+		total = n
+	}
+
+	if n, ok := args.Get(2).(error); ok {
+		err = n
+	}
+
+	return resp, total, err
+}
+
+func (o *MockTask) TransitionTask(req *dto.TransitionTaskReqDTO) (*dto.TaskTransitionEventDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.TaskTransitionEventDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TaskTransitionEventDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) BulkTask(req *dto.BulkTaskReqDTO) ([]*dto.BulkTaskResultDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*dto.BulkTaskResultDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.BulkTaskResultDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) StartTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.TimeEntryRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TimeEntryRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) StopTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.TimeEntryRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.TimeEntryRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) GetTimeReport(req *dto.GetTimeReportReqDTO) ([]*dto.TimeReportRowDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp []*dto.TimeReportRowDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).([]*dto.TimeReportRowDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
+
+func (o *MockTask) SnoozeTask(req *dto.SnoozeTaskReqDTO) (*dto.GetTaskRespDTO, string, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.GetTaskRespDTO
+		from string
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.GetTaskRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(string); ok {
+		from = n
+	}
+
+	if n, ok := args.Get(2).(error); ok {
+		err = n
+	}
+
+	return resp, from, err
+}
+
+func (o *MockTask) MoveTask(req *dto.MoveTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	args := o.Called(req)
+
+	var (
+		resp *dto.GetTaskRespDTO
+		err  error
+	)
+
+	if n, ok := args.Get(0).(*dto.GetTaskRespDTO); ok {
+		resp = n
+	}
+
+	if n, ok := args.Get(1).(error); ok {
+		err = n
+	}
+
+	return resp, err
+}
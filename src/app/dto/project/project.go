@@ -0,0 +1,114 @@
+package project
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// colorPattern adalah format warna project dalam hex, contoh #ff8800
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// CreateProjectReqDTO digunakan untuk membuat project baru milik user
+type CreateProjectReqDTO struct {
+	UserID int64  `json:"-"`
+	Name   string `json:"name"`
+	Color  string `json:"color,omitempty"`
+}
+
+func (dto *CreateProjectReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Name, validation.Required, validation.Length(1, 100)),
+		validation.Field(&dto.Color, validation.Match(colorPattern)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateProjectReqDTO digunakan untuk mengubah project.
+// Field yang bernilai nil tidak diubah.
+type UpdateProjectReqDTO struct {
+	ID       int64   `json:"-"`
+	UserID   int64   `json:"-"`
+	Name     *string `json:"name"`
+	Color    *string `json:"color"`
+	Archived *bool   `json:"archived"`
+}
+
+func (dto *UpdateProjectReqDTO) Validate() error {
+	if dto.Name == nil && dto.Color == nil && dto.Archived == nil {
+		return errors.New("nothing to update")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Name, validation.NilOrNotEmpty, validation.Length(1, 100)),
+		validation.Field(&dto.Color, validation.Match(colorPattern)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetProjectListReqDTO digunakan untuk mengambil daftar project milik user
+type GetProjectListReqDTO struct {
+	UserID          int64 `json:"user_id"`
+	IncludeArchived bool  `json:"include_archived"`
+}
+
+// GetProjectReqDTO digunakan untuk mengambil satu project milik user
+type GetProjectReqDTO struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// DeleteProjectReqDTO digunakan untuk menghapus project, task di dalamnya tidak ikut terhapus
+type DeleteProjectReqDTO struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// ReorderProjectReqDTO digunakan untuk mengubah urutan project.
+// ProjectIDs berisi id project dengan urutan yang baru.
+type ReorderProjectReqDTO struct {
+	UserID     int64   `json:"-"`
+	ProjectIDs []int64 `json:"project_ids"`
+}
+
+func (dto *ReorderProjectReqDTO) Validate() error {
+	seen := map[int64]bool{}
+	for _, id := range dto.ProjectIDs {
+		if seen[id] {
+			return errors.New("project_ids: must not contain duplicates")
+		}
+		seen[id] = true
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.ProjectIDs, validation.Required),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ProjectRespDTO adalah data project beserta jumlah task per status
+type ProjectRespDTO struct {
+	ID              int64     `json:"id" db:"id"`
+	Name            string    `json:"name" db:"name"`
+	Color           string    `json:"color" db:"color"`
+	Archived        bool      `json:"archived" db:"archived"`
+	Position        int       `json:"position" db:"position"`
+	PendingCount    int64     `json:"pending_count" db:"pending_count"`
+	InProgressCount int64     `json:"in_progress_count" db:"in_progress_count"`
+	DoneCount       int64     `json:"done_count" db:"done_count"`
+	ExpiredCount    int64     `json:"expired_count" db:"expired_count"`
+	CancelledCount  int64     `json:"cancelled_count" db:"cancelled_count"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
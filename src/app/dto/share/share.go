@@ -0,0 +1,75 @@
+package share
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/go-ozzo/ozzo-validation/is"
+)
+
+// Tingkat akses terhadap task atau project
+const (
+	PermissionViewer = "viewer" // Hanya bisa melihat
+	PermissionEditor = "editor" // Bisa melihat dan mengubah
+	PermissionOwner  = "owner"  // Pemilik, bisa menghapus dan membagikan
+)
+
+// Permissions adalah tingkat akses yang bisa diberikan ke kolaborator
+var Permissions = []interface{}{PermissionViewer, PermissionEditor}
+
+// permissionLevels mengurutkan tingkat akses dari yang paling sempit
+var permissionLevels = map[string]int{
+	PermissionViewer: 1,
+	PermissionEditor: 2,
+	PermissionOwner:  3,
+}
+
+// Allows mengembalikan true jika permission mencakup tingkat akses required
+func Allows(permission string, required string) bool {
+	return permissionLevels[permission] >= permissionLevels[required]
+}
+
+// ShareReqDTO digunakan untuk membagikan task atau project ke user lain berdasarkan email.
+// Jika user sudah pernah diundang, permission-nya diperbarui.
+type ShareReqDTO struct {
+	ID         int64  `json:"-"`
+	UserID     int64  `json:"-"`
+	Email      string `json:"email"`
+	Permission string `json:"permission"`
+}
+
+func (dto *ShareReqDTO) Validate() error {
+	dto.Email = strings.TrimSpace(dto.Email)
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Email, validation.Required, is.Email),
+		validation.Field(&dto.Permission, validation.Required, validation.In(Permissions...)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetShareListReqDTO digunakan untuk mengambil daftar kolaborator task atau project
+type GetShareListReqDTO struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// RemoveShareReqDTO digunakan untuk mencabut akses kolaborator
+type RemoveShareReqDTO struct {
+	ID          int64 `json:"id"`
+	UserID      int64 `json:"user_id"`
+	ShareUserID int64 `json:"share_user_id"`
+}
+
+// ShareRespDTO adalah kolaborator beserta tingkat aksesnya
+type ShareRespDTO struct {
+	UserID     int64     `json:"user_id" db:"user_id"`
+	Name       string    `json:"name" db:"name"`
+	Email      string    `json:"email" db:"email"`
+	Permission string    `json:"permission" db:"permission"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,75 @@
+package tag
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// colorPattern adalah format warna tag dalam hex, contoh #ff8800
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// CreateTagReqDTO digunakan untuk membuat tag baru milik user
+type CreateTagReqDTO struct {
+	UserID int64  `json:"-"`
+	Name   string `json:"name"`
+	Color  string `json:"color,omitempty"`
+}
+
+func (dto *CreateTagReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Name, validation.Required, validation.Length(1, 50)),
+		validation.Field(&dto.Color, validation.Match(colorPattern)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateTagReqDTO digunakan untuk mengubah nama atau warna tag.
+// Field yang bernilai nil tidak diubah.
+type UpdateTagReqDTO struct {
+	ID     int64   `json:"-"`
+	UserID int64   `json:"-"`
+	Name   *string `json:"name"`
+	Color  *string `json:"color"`
+}
+
+func (dto *UpdateTagReqDTO) Validate() error {
+	if dto.Name == nil && dto.Color == nil {
+		return errors.New("nothing to update")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Name, validation.NilOrNotEmpty, validation.Length(1, 50)),
+		validation.Field(&dto.Color, validation.Match(colorPattern)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTagListReqDTO digunakan untuk mengambil daftar tag milik user
+type GetTagListReqDTO struct {
+	UserID int64 `json:"user_id"`
+}
+
+// DeleteTagReqDTO digunakan untuk menghapus tag milik user
+type DeleteTagReqDTO struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// TagRespDTO adalah data tag beserta jumlah task yang memakainya
+type TagRespDTO struct {
+	ID        int64     `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Color     string    `json:"color" db:"color"`
+	TaskCount int64     `json:"task_count" db:"task_count"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
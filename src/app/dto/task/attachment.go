@@ -0,0 +1,63 @@
+package task
+
+import (
+	"io"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// AddAttachmentReqDTO digunakan untuk mengunggah lampiran ke task.
+// File dibaca secara streaming dan tidak pernah dimuat penuh ke memori.
+type AddAttachmentReqDTO struct {
+	TaskID      int64     `json:"-"`
+	UserID      int64     `json:"-"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	File        io.Reader `json:"-"`
+	StorageKey  string    `json:"-"` // Diisi use case setelah file tersimpan di blob store
+	Size        int64     `json:"-"` // Diisi use case setelah file tersimpan di blob store
+}
+
+func (dto *AddAttachmentReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.FileName, validation.Required, validation.Length(1, 255)),
+		validation.Field(&dto.ContentType, validation.Required, validation.Length(1, 255)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetAttachmentListReqDTO digunakan untuk mengambil daftar lampiran task
+type GetAttachmentListReqDTO struct {
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// GetAttachmentReqDTO digunakan untuk mengunduh satu lampiran task
+type GetAttachmentReqDTO struct {
+	ID     int64 `json:"id"`
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// DeleteAttachmentReqDTO digunakan untuk menghapus lampiran task
+type DeleteAttachmentReqDTO struct {
+	ID     int64 `json:"id"`
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// AttachmentRespDTO adalah data lampiran task
+type AttachmentRespDTO struct {
+	ID          int64     `json:"id" db:"id"`
+	TaskID      int64     `json:"task_id" db:"task_id"`
+	UploadedBy  int64     `json:"uploaded_by" db:"user_id"`
+	FileName    string    `json:"file_name" db:"file_name"`
+	ContentType string    `json:"content_type" db:"content_type"`
+	Size        int64     `json:"size" db:"size_bytes"`
+	StorageKey  string    `json:"-" db:"storage_key"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
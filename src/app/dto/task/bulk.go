@@ -0,0 +1,127 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// Operasi yang didukung oleh bulk task
+const (
+	BulkFinish      = "finish"
+	BulkDelete      = "delete"
+	BulkRestore     = "restore"
+	BulkSetProject  = "set_project"
+	BulkSetPriority = "set_priority"
+)
+
+// Mode bulk task. Atomic membatalkan semua operasi jika ada satu yang gagal,
+// best_effort tetap menyimpan operasi yang berhasil.
+const (
+	BulkModeAtomic     = "atomic"
+	BulkModeBestEffort = "best_effort"
+)
+
+// Status hasil setiap operasi bulk task
+const (
+	BulkStatusOK         = "ok"
+	BulkStatusFailed     = "failed"
+	BulkStatusRolledBack = "rolled_back" // Berhasil dijalankan tetapi dibatalkan karena operasi lain gagal
+	BulkStatusSkipped    = "skipped"     // Tidak dijalankan karena operasi lain gagal
+)
+
+// MaxBulkOperations adalah jumlah maksimal operasi dalam satu request bulk task
+const MaxBulkOperations = 100
+
+// BulkTaskReqDTO digunakan untuk menjalankan banyak operasi task sekaligus
+type BulkTaskReqDTO struct {
+	UserID     int64               `json:"-"`
+	Mode       string              `json:"mode"` // Default atomic
+	Operations []*BulkOperationDTO `json:"operations"`
+	Audit      AuditDTO            `json:"-"`
+}
+
+func (dto *BulkTaskReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Mode, validation.In(BulkModeAtomic, BulkModeBestEffort)),
+		// NotNil menolak elemen null sebelum Validate milik tiap operasi dipanggil
+		validation.Field(&dto.Operations, validation.Required, validation.Length(1, MaxBulkOperations), validation.Each(validation.NotNil)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BulkOperationDTO adalah satu operasi pada bulk task.
+// ProjectID wajib untuk set_project (0 berarti keluar dari project) dan Priority wajib untuk set_priority.
+type BulkOperationDTO struct {
+	Index     int      `json:"-"` // Posisi operasi di request, diisi use case
+	Op        string   `json:"op"`
+	ID        int64    `json:"id"`
+	ProjectID *int64   `json:"project_id,omitempty"`
+	Priority  *string  `json:"priority,omitempty"`
+	UserID    int64    `json:"-"` // Pemilik task, diisi use case
+	From      []string `json:"-"` // Status asal yang boleh diselesaikan, diisi use case
+}
+
+func (dto *BulkOperationDTO) Validate() error {
+	if dto.Op == BulkSetProject && dto.ProjectID == nil {
+		return errors.New("project_id: cannot be blank")
+	}
+	if dto.Op == BulkSetPriority && dto.Priority == nil {
+		return errors.New("priority: cannot be blank")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Op, validation.Required, validation.In(BulkFinish, BulkDelete, BulkRestore, BulkSetProject, BulkSetPriority)),
+		validation.Field(&dto.ID, validation.Required, validation.Min(int64(1))),
+		validation.Field(&dto.ProjectID, validation.Min(int64(0))),
+		validation.Field(&dto.Priority, validation.NilOrNotEmpty, validation.In(Priorities...)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BulkTaskResultDTO adalah hasil satu operasi bulk task sesuai urutan di request
+type BulkTaskResultDTO struct {
+	Index      int                     `json:"index"`
+	ID         int64                   `json:"id"`
+	Op         string                  `json:"op"`
+	Status     string                  `json:"status"`
+	Code       uint                    `json:"code,omitempty"` // Kode error jika operasi gagal
+	Message    string                  `json:"message,omitempty"`
+	Err        error                   `json:"-"` // Error asli dari repository atau use case
+	Task       *GetTaskRespDTO         `json:"-"` // Task terbaru untuk restore, set_project, dan set_priority
+	Transition *TaskTransitionEventDTO `json:"-"` // Perpindahan status untuk finish
+}
+
+// BulkTaskRespDTO adalah hasil bulk task. Committed bernilai false jika mode atomic dan ada operasi yang gagal.
+type BulkTaskRespDTO struct {
+	Mode      string               `json:"mode"`
+	Committed bool                 `json:"committed"`
+	Succeeded int                  `json:"succeeded"`
+	Failed    int                  `json:"failed"`
+	Results   []*BulkTaskResultDTO `json:"results"`
+}
+
+// TaskBulkEventDTO adalah payload event taskbulk, satu pesan untuk semua operasi yang tersimpan
+type TaskBulkEventDTO struct {
+	ActorID    int64                `json:"actor_id"`
+	RequestID  string               `json:"request_id,omitempty"`
+	Mode       string               `json:"mode"`
+	Operations []*TaskBulkEventItem `json:"operations"`
+	OccurredAt time.Time            `json:"occurred_at"`
+}
+
+// TaskBulkEventItem adalah satu operasi yang tersimpan pada event taskbulk
+type TaskBulkEventItem struct {
+	ID         int64                   `json:"id"`
+	Op         string                  `json:"op"`
+	ProjectID  *int64                  `json:"project_id,omitempty"`
+	Priority   *string                 `json:"priority,omitempty"`
+	Transition *TaskTransitionEventDTO `json:"transition,omitempty"`
+}
@@ -0,0 +1,20 @@
+package task
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkTaskRejectsNullOperation(t *testing.T) {
+	req := &BulkTaskReqDTO{}
+	assert.Nil(t, json.Unmarshal([]byte(`{"operations":[null]}`), req))
+
+	assert.NotPanics(t, func() {
+		assert.NotNil(t, req.Validate())
+	})
+
+	req.Operations = []*BulkOperationDTO{{Op: BulkFinish, ID: 1}}
+	assert.Nil(t, req.Validate())
+}
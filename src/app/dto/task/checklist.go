@@ -0,0 +1,91 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// GetChecklistReqDTO digunakan untuk mengambil checklist sebuah task
+type GetChecklistReqDTO struct {
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// AddChecklistItemReqDTO digunakan untuk menambahkan item checklist ke task
+type AddChecklistItemReqDTO struct {
+	TaskID int64  `json:"-"`
+	UserID int64  `json:"-"`
+	Title  string `json:"title"`
+}
+
+func (dto *AddChecklistItemReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Title, validation.Required, validation.Length(1, 255)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// CheckChecklistItemReqDTO digunakan untuk menandai item checklist selesai atau belum
+type CheckChecklistItemReqDTO struct {
+	ID     int64    `json:"id"`
+	TaskID int64    `json:"task_id"`
+	UserID int64    `json:"user_id"`
+	Done   bool     `json:"done"`
+	Audit  AuditDTO `json:"-"` // Dipakai jika task ikut diselesaikan oleh auto_finish
+}
+
+// ReorderChecklistReqDTO digunakan untuk mengubah urutan item checklist.
+// ItemIDs harus berisi seluruh id item checklist task dengan urutan yang baru,
+// daftar yang tidak lengkap ditolak agar tidak ada posisi yang kembar.
+type ReorderChecklistReqDTO struct {
+	TaskID  int64   `json:"-"`
+	UserID  int64   `json:"-"`
+	ItemIDs []int64 `json:"item_ids"`
+}
+
+func (dto *ReorderChecklistReqDTO) Validate() error {
+	seen := map[int64]bool{}
+	for _, id := range dto.ItemIDs {
+		if seen[id] {
+			return errors.New("item_ids: must not contain duplicates")
+		}
+		seen[id] = true
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.ItemIDs, validation.Required),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteChecklistItemReqDTO digunakan untuk menghapus item checklist
+type DeleteChecklistItemReqDTO struct {
+	ID     int64 `json:"id"`
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// ChecklistItemRespDTO adalah data item checklist
+type ChecklistItemRespDTO struct {
+	ID        int64     `json:"id" db:"id"`
+	TaskID    int64     `json:"task_id" db:"task_id"`
+	Title     string    `json:"title" db:"title"`
+	IsDone    bool      `json:"is_done" db:"is_done"`
+	Position  int       `json:"position" db:"position"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ChecklistSummaryDTO adalah jumlah item checklist sebuah task
+type ChecklistSummaryDTO struct {
+	Total int `db:"total"`
+	Done  int `db:"done"`
+}
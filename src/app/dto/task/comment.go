@@ -0,0 +1,96 @@
+package task
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// AddCommentReqDTO digunakan untuk menambahkan komentar ke task
+type AddCommentReqDTO struct {
+	TaskID int64  `json:"-"`
+	UserID int64  `json:"-"`
+	Body   string `json:"body"`
+}
+
+func (dto *AddCommentReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Body, validation.Required, validation.Length(1, 5000)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetCommentListReqDTO digunakan untuk mengambil komentar task per halaman
+type GetCommentListReqDTO struct {
+	TaskID  int64 `json:"task_id"`
+	UserID  int64 `json:"user_id"`
+	Page    int64 `json:"page"`
+	PerPage int64 `json:"per_page"`
+}
+
+func (dto *GetCommentListReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Page, validation.Min(int64(1))),
+		validation.Field(&dto.PerPage, validation.Min(int64(1)), validation.Max(int64(100))),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateCommentReqDTO digunakan untuk mengubah komentar milik user sendiri
+type UpdateCommentReqDTO struct {
+	ID     int64  `json:"-"`
+	TaskID int64  `json:"-"`
+	UserID int64  `json:"-"`
+	Body   string `json:"body"`
+}
+
+func (dto *UpdateCommentReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Body, validation.Required, validation.Length(1, 5000)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteCommentReqDTO digunakan untuk menghapus komentar milik user sendiri
+type DeleteCommentReqDTO struct {
+	ID     int64 `json:"id"`
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// CommentRespDTO adalah komentar task beserta penulisnya
+type CommentRespDTO struct {
+	ID        int64            `json:"id" db:"id"`
+	TaskID    int64            `json:"task_id" db:"task_id"`
+	Body      string           `json:"body" db:"body"`
+	Author    CommentAuthorDTO `json:"author" db:"author"`
+	CreatedAt time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time        `json:"updated_at" db:"updated_at"`
+}
+
+// CommentAuthorDTO adalah penulis komentar
+type CommentAuthorDTO struct {
+	ID   int64  `json:"id" db:"id"`
+	Name string `json:"name" db:"name"`
+}
+
+// TaskCommentedEventDTO adalah payload event ketika task mendapat komentar baru
+type TaskCommentedEventDTO struct {
+	ID          int64     `json:"id"`
+	TaskID      int64     `json:"task_id"`
+	TaskTitle   string    `json:"task_title"`
+	TaskOwnerID int64     `json:"task_owner_id"`
+	AuthorID    int64     `json:"author_id"`
+	AuthorName  string    `json:"author_name"`
+	Body        string    `json:"body"`
+	CreatedAt   time.Time `json:"created_at"`
+}
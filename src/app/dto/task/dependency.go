@@ -0,0 +1,51 @@
+package task
+
+import (
+	"errors"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/lib/pq"
+)
+
+// TaskIDs adalah daftar id task yang dibaca dari array database
+type TaskIDs []int64
+
+// Scan membaca array id task dari database, array kosong tetap menjadi daftar kosong
+func (ids *TaskIDs) Scan(src interface{}) error {
+	var values pq.Int64Array
+	if err := values.Scan(src); err != nil {
+		return err
+	}
+
+	*ids = append(TaskIDs{}, values...)
+	return nil
+}
+
+// AddDependencyReqDTO digunakan untuk menandai task tidak bisa diselesaikan
+// sebelum task DependsOnID selesai
+type AddDependencyReqDTO struct {
+	TaskID      int64 `json:"-"`
+	UserID      int64 `json:"-"`
+	DependsOnID int64 `json:"depends_on_id"`
+}
+
+func (dto *AddDependencyReqDTO) Validate() error {
+	if dto.DependsOnID == dto.TaskID {
+		return errors.New("depends_on_id: task cannot depend on itself")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.DependsOnID, validation.Required, validation.Min(int64(1))),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RemoveDependencyReqDTO digunakan untuk menghapus ketergantungan task
+type RemoveDependencyReqDTO struct {
+	TaskID      int64 `json:"task_id"`
+	UserID      int64 `json:"user_id"`
+	DependsOnID int64 `json:"depends_on_id"`
+}
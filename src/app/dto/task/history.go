@@ -0,0 +1,65 @@
+package task
+
+import (
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/jmoiron/sqlx/types"
+)
+
+// Jenis perubahan yang dicatat di riwayat task
+const (
+	TaskEventCreate  = "create"
+	TaskEventUpdate  = "update"
+	TaskEventStart   = "start"
+	TaskEventFinish  = "finish"
+	TaskEventCancel  = "cancel"
+	TaskEventReopen  = "reopen"
+	TaskEventExpire  = "expire"
+	TaskEventSnooze  = "snooze"
+	TaskEventDelete  = "delete"
+	TaskEventRestore = "restore"
+)
+
+// MaxRequestIDLength adalah panjang maksimum X-Request-ID yang disimpan di riwayat task
+const MaxRequestIDLength = 128
+
+// AuditDTO adalah pelaku dan request yang memicu perubahan task.
+// Ikut dikirim lewat NATS agar perubahan async tetap tercatat pelakunya.
+type AuditDTO struct {
+	ActorID   int64  `json:"actor_id,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// GetTaskHistoryReqDTO digunakan untuk mengambil riwayat task per halaman
+type GetTaskHistoryReqDTO struct {
+	TaskID  int64 `json:"task_id"`
+	UserID  int64 `json:"user_id"`
+	Page    int64 `json:"page"`
+	PerPage int64 `json:"per_page"`
+}
+
+func (dto *GetTaskHistoryReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Page, validation.Min(int64(1))),
+		validation.Field(&dto.PerPage, validation.Min(int64(1)), validation.Max(int64(100))),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TaskEventRespDTO adalah satu perubahan pada riwayat task.
+// OldValues dan NewValues hanya berisi field yang berubah.
+type TaskEventRespDTO struct {
+	ID        int64          `json:"id" db:"id"`
+	TaskID    int64          `json:"task_id" db:"task_id"`
+	Action    string         `json:"action" db:"action"`
+	ActorID   *int64         `json:"actor_id" db:"actor_id"`
+	ActorName *string        `json:"actor_name" db:"actor_name"`
+	OldValues types.JSONText `json:"old_values" db:"old_values"`
+	NewValues types.JSONText `json:"new_values" db:"new_values"`
+	RequestID *string        `json:"request_id" db:"request_id"`
+	CreatedAt time.Time      `json:"created_at" db:"created_at"`
+}
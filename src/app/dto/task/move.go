@@ -0,0 +1,38 @@
+package task
+
+import (
+	"errors"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// MoveTaskReqDTO digunakan untuk memindahkan task pada urutan manual pemilik task.
+// After adalah task yang berada tepat di atas posisi baru, Before adalah task yang
+// berada tepat di bawahnya. Cukup salah satu jika task dipindah ke awal atau akhir daftar.
+type MoveTaskReqDTO struct {
+	ID     int64  `json:"-"`
+	UserID int64  `json:"-"`
+	Before *int64 `json:"before"`
+	After  *int64 `json:"after"`
+}
+
+func (dto *MoveTaskReqDTO) Validate() error {
+	if dto.Before == nil && dto.After == nil {
+		return errors.New("before: at least one of before or after is required")
+	}
+	if (dto.Before != nil && *dto.Before == dto.ID) || (dto.After != nil && *dto.After == dto.ID) {
+		return errors.New("before: cannot move a task relative to itself")
+	}
+	if dto.Before != nil && dto.After != nil && *dto.Before == *dto.After {
+		return errors.New("before: must be different from after")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Before, validation.Min(int64(1))),
+		validation.Field(&dto.After, validation.Min(int64(1))),
+	); err != nil {
+		return err
+	}
+	return nil
+}
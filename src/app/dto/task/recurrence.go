@@ -0,0 +1,111 @@
+package task
+
+import (
+	"errors"
+	"time"
+	"todo_list/src/infra/rrule"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// validRecurrence memastikan nilai recurrence adalah RRULE yang didukung
+var validRecurrence = validation.By(func(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if s, ok := value.(string); !isNil && ok && s != "" {
+		if _, err := rrule.Parse(s); err != nil {
+			return err
+		}
+	}
+	return nil
+})
+
+// validTimezone memastikan nilai timezone adalah nama zona waktu IANA, contoh Asia/Jakarta
+var validTimezone = validation.By(func(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if s, ok := value.(string); !isNil && ok && s != "" {
+		if _, err := time.LoadLocation(s); err != nil {
+			return errors.New("unknown time zone")
+		}
+	}
+	return nil
+})
+
+// GetRecurrenceReqDTO digunakan untuk mengambil seri berulang dari salah satu instance task
+type GetRecurrenceReqDTO struct {
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// UpdateRecurrenceReqDTO digunakan untuk mengubah aturan dan template seri berulang.
+// Perubahan berlaku untuk instance berikutnya, instance yang sudah ada tidak diubah.
+type UpdateRecurrenceReqDTO struct {
+	TaskID      int64   `json:"-"`
+	UserID      int64   `json:"-"`
+	Recurrence  *string `json:"recurrence"`
+	Timezone    *string `json:"timezone"`
+	Title       *string `json:"title"`
+	Description *string `json:"description"`
+	Priority    *string `json:"priority"`
+	Notes       *string `json:"notes"`
+}
+
+func (dto *UpdateRecurrenceReqDTO) Validate() error {
+	if dto.Recurrence == nil && dto.Timezone == nil && dto.Title == nil &&
+		dto.Description == nil && dto.Priority == nil && dto.Notes == nil {
+		return errors.New("nothing to update")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Recurrence, validation.NilOrNotEmpty, validRecurrence),
+		validation.Field(&dto.Timezone, validation.NilOrNotEmpty, validTimezone),
+		validation.Field(&dto.Title, validation.NilOrNotEmpty, validation.Length(1, 255)),
+		validation.Field(&dto.Description, validation.Length(0, 10000)),
+		validation.Field(&dto.Priority, validation.NilOrNotEmpty, validation.In(Priorities...)),
+		validation.Field(&dto.Notes, validation.Length(0, 10000)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// StopRecurrenceReqDTO digunakan untuk menghentikan seri berulang,
+// instance yang sedang berjalan tetap ada
+type StopRecurrenceReqDTO struct {
+	TaskID int64 `json:"task_id"`
+	UserID int64 `json:"user_id"`
+}
+
+// RecurrenceRespDTO adalah data seri berulang beserta template task-nya
+type RecurrenceRespDTO struct {
+	ID          int64     `json:"id" db:"id"`
+	Recurrence  string    `json:"recurrence" db:"rrule"`
+	Timezone    string    `json:"timezone" db:"timezone"`
+	DTStart     time.Time `json:"dtstart" db:"dtstart"`
+	Title       string    `json:"title" db:"title"`
+	Description string    `json:"description" db:"description"`
+	Priority    string    `json:"priority" db:"priority"`
+	Notes       string    `json:"notes" db:"notes"`
+	Active      bool      `json:"active" db:"active"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TaskRecurrenceDTO adalah data seri berulang milik sebuah instance task,
+// digunakan untuk menghitung instance berikutnya
+type TaskRecurrenceDTO struct {
+	ID            int64     `db:"id"`
+	RRule         string    `db:"rrule"`
+	Timezone      string    `db:"timezone"`
+	DTStart       time.Time `db:"dtstart"`
+	Active        bool      `db:"active"`
+	LastTaskID    *int64    `db:"last_task_id"`
+	TaskExpiresAt time.Time `db:"task_expires_at"`
+}
+
+// NextInstanceReqDTO digunakan untuk membuat instance berikutnya dari seri berulang
+type NextInstanceReqDTO struct {
+	RecurrenceID int64
+	PrevTaskID   int64
+	ExpiresAt    time.Time
+}
@@ -0,0 +1,57 @@
+package task
+
+import (
+	"time"
+	"todo_list/src/infra/helper"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/lib/pq"
+)
+
+// MaxReminders adalah jumlah maksimal pengingat pada satu task
+const MaxReminders = 5
+
+// ReminderClaimLease adalah batas waktu pengingat yang sudah diklaim untuk ditandai terkirim.
+// Jika proses mati di antara klaim dan publish, pengingat diambil lagi setelah lease habis.
+const ReminderClaimLease = 5 * time.Minute
+
+// validReminder memastikan offset pengingat berformat angka diikuti m, h, d, atau w
+var validReminder = validation.By(func(value interface{}) error {
+	value, isNil := validation.Indirect(value)
+	if s, ok := value.(string); !isNil && ok {
+		if _, err := helper.ParseReminderOffset(s); err != nil {
+			return err
+		}
+	}
+	return nil
+})
+
+// ReminderOffsets adalah daftar offset pengingat task, disimpan dalam detik
+// dan ditampilkan ke client dalam format seperti 1h atau 1d
+type ReminderOffsets []string
+
+// Scan membaca array offset_seconds dari database
+func (r *ReminderOffsets) Scan(src interface{}) error {
+	var seconds pq.Int64Array
+	if err := seconds.Scan(src); err != nil {
+		return err
+	}
+
+	offsets := ReminderOffsets{}
+	for _, s := range seconds {
+		offsets = append(offsets, helper.FormatReminderOffset(s))
+	}
+	*r = offsets
+	return nil
+}
+
+// TaskReminderEventDTO adalah payload event ketika pengingat task jatuh tempo
+type TaskReminderEventDTO struct {
+	ID            int64     `json:"id" db:"id"`
+	TaskID        int64     `json:"task_id" db:"task_id"`
+	UserID        int64     `json:"user_id" db:"user_id"`
+	Title         string    `json:"title" db:"title"`
+	OffsetSeconds int64     `json:"offset_seconds" db:"offset_seconds"`
+	RemindAt      time.Time `json:"remind_at" db:"remind_at"`
+	ExpiresAt     time.Time `json:"expires_at" db:"expires_at"`
+}
@@ -0,0 +1,46 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// Preset snooze, tomorrow dan next_week dihitung pada pukul 09:00 di zona waktu request
+const (
+	SnoozeOneHour  = "1h"
+	SnoozeTomorrow = "tomorrow"
+	SnoozeNextWeek = "next_week" // Senin berikutnya
+)
+
+// SnoozeHour adalah jam yang dipakai preset tomorrow dan next_week
+const SnoozeHour = 9
+
+// SnoozeTaskReqDTO digunakan untuk mengundur expires_at task dengan preset atau waktu tertentu.
+// Task yang sudah expired kembali menjadi pending.
+type SnoozeTaskReqDTO struct {
+	ID        int64      `json:"-"`
+	UserID    int64      `json:"-"`
+	Preset    string     `json:"preset"`
+	Until     *time.Time `json:"until"`    // Waktu kadaluarsa baru, tidak bisa digabung dengan preset
+	Timezone  string     `json:"timezone"` // Zona waktu untuk preset, default UTC
+	ExpiresAt time.Time  `json:"-"`        // Hasil perhitungan preset atau until, diisi use case
+	From      []string   `json:"-"`        // Status yang boleh di-snooze, diisi use case
+	Audit     AuditDTO   `json:"-"`
+}
+
+func (dto *SnoozeTaskReqDTO) Validate() error {
+	if (dto.Preset == "") == (dto.Until == nil) {
+		return errors.New("preset: exactly one of preset or until is required")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Preset, validation.In(SnoozeOneHour, SnoozeTomorrow, SnoozeNextWeek)),
+		validation.Field(&dto.Timezone, validTimezone),
+	); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package task
+
+import "time"
+
+// Status task
+const (
+	StatusPending    = "pending"
+	StatusInProgress = "in_progress"
+	StatusDone       = "done"
+	StatusExpired    = "expired"
+	StatusCancelled  = "cancelled"
+)
+
+// Statuses adalah semua status task yang valid
+var Statuses = []interface{}{StatusPending, StatusInProgress, StatusDone, StatusExpired, StatusCancelled}
+
+// TransitionTaskReqDTO digunakan untuk memindahkan status task, contoh start, cancel, atau reopen
+type TransitionTaskReqDTO struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	Action    string     `json:"action"`
+	ExpiresAt *time.Time `json:"expires_at"` // Kadaluarsa baru saat reopen, wajib jika expires_at task sudah lewat
+	From      []string   `json:"-"`          // Status asal yang diizinkan, diisi use case dari state machine
+	To        string     `json:"-"`
+	Audit     AuditDTO   `json:"-"`
+}
+
+// TaskTransitionEventDTO adalah payload event tasktransition untuk setiap perpindahan status task
+type TaskTransitionEventDTO struct {
+	TaskID     int64     `json:"task_id" db:"id"`
+	UserID     int64     `json:"user_id" db:"user_id"` // Pemilik task
+	Title      string    `json:"title" db:"title"`
+	Action     string    `json:"action" db:"-"`
+	From       string    `json:"from" db:"-"`
+	To         string    `json:"to" db:"status"`
+	ActorID    int64     `json:"actor_id,omitempty" db:"-"` // 0 berarti perubahan oleh sistem
+	RequestID  string    `json:"request_id,omitempty" db:"-"`
+	OccurredAt time.Time `json:"occurred_at" db:"updated_at"`
+}
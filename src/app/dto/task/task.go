@@ -1,22 +1,47 @@
 package task
 
 import (
+	"errors"
 	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation"
 )
 
+// Priorities adalah daftar prioritas task yang valid
+var Priorities = []interface{}{"low", "medium", "high", "urgent"}
+
+// MaxTags adalah jumlah maksimal tag pada satu task
+const MaxTags = 20
+
 // CreateTaskReqDTO digunakan untuk membuat task baru
 type CreateTaskReqDTO struct {
-	UserID    int64     `json:"user_id"`
-	Title     string    `json:"title"`
-	ExpiresAt time.Time `json:"expires_at"`
+	UserID      int64     `json:"user_id"`
+	Title       string    `json:"title"`
+	Description string    `json:"description,omitempty"`
+	Priority    string    `json:"priority,omitempty"`
+	Notes       string    `json:"notes,omitempty"`
+	AutoFinish  bool      `json:"auto_finish,omitempty"`
+	Tags        []string  `json:"tags,omitempty"`
+	ProjectID   *int64    `json:"project_id,omitempty"`
+	Recurrence  string    `json:"recurrence,omitempty"` // RRULE, contoh FREQ=WEEKLY;BYDAY=MO
+	Timezone    string    `json:"timezone,omitempty"`   // Zona waktu seri berulang, default UTC
+	Reminders   []string  `json:"reminders,omitempty"`  // Offset pengingat sebelum expires_at, contoh 1h atau 1d
+	ExpiresAt   time.Time `json:"expires_at"`
+	Audit       AuditDTO  `json:"audit"` // Diisi handler dari token dan X-Request-ID
 }
 
 func (dto *CreateTaskReqDTO) Validate() error {
 	if err := validation.ValidateStruct(
 		dto,
-		validation.Field(&dto.Title, validation.Required),
+		validation.Field(&dto.Title, validation.Required, validation.Length(1, 255)),
+		validation.Field(&dto.Description, validation.Length(0, 10000)),
+		validation.Field(&dto.Priority, validation.In(Priorities...)),
+		validation.Field(&dto.Notes, validation.Length(0, 10000)),
+		validation.Field(&dto.Tags, validation.Length(0, MaxTags), validation.Each(validation.Length(1, 50))),
+		validation.Field(&dto.ProjectID, validation.Min(int64(1))),
+		validation.Field(&dto.Recurrence, validation.Length(0, 255), validRecurrence),
+		validation.Field(&dto.Timezone, validTimezone),
+		validation.Field(&dto.Reminders, validation.Length(0, MaxReminders), validation.Each(validReminder)),
 		validation.Field(&dto.ExpiresAt, validation.Required),
 	); err != nil {
 		return err
@@ -25,21 +50,245 @@ func (dto *CreateTaskReqDTO) Validate() error {
 }
 
 type FinishtTaskReqDTO struct {
-	ID int64 `json:"id"`
+	ID     int64    `json:"id"`
+	UserID int64    `json:"user_id"`
+	Audit  AuditDTO `json:"audit"` // Diisi handler dari token dan X-Request-ID
+	From   []string `json:"-"`     // Status asal yang boleh diselesaikan, diisi use case
+}
+
+func (dto *FinishtTaskReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.ID, validation.Required),
+		validation.Field(&dto.UserID, validation.Required),
+	); err != nil {
+		return err
+	}
+	return nil
 }
 
-// UpdateTaskReqDTO digunakan untuk memperbarui task yang sudah ada
+// GetTaskByIDReqDTO digunakan untuk mengambil satu task milik user
+type GetTaskByIDReqDTO struct {
+	ID     int64 `json:"id"`
+	UserID int64 `json:"user_id"`
+}
+
+// UpdateTaskReqDTO digunakan untuk memperbarui sebagian field task yang sudah ada.
+// Field yang bernilai nil tidak diubah, Tags berisi daftar kosong akan menghapus semua tag
+// ProjectID bernilai 0 akan mengeluarkan task dari project, dan Reminders berisi daftar kosong
+// akan menghapus semua pengingat.
+type UpdateTaskReqDTO struct {
+	ID          int64      `json:"-"`
+	UserID      int64      `json:"-"`
+	Title       *string    `json:"title"`
+	Description *string    `json:"description"`
+	Priority    *string    `json:"priority"`
+	Notes       *string    `json:"notes"`
+	AutoFinish  *bool      `json:"auto_finish"`
+	Tags        *[]string  `json:"tags"`
+	ProjectID   *int64     `json:"project_id"`
+	Reminders   *[]string  `json:"reminders"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	Audit       AuditDTO   `json:"-"`
+}
+
+func (dto *UpdateTaskReqDTO) Validate() error {
+	if dto.Title == nil && dto.Description == nil && dto.Priority == nil && dto.Notes == nil &&
+		dto.AutoFinish == nil && dto.Tags == nil && dto.ProjectID == nil && dto.Reminders == nil && dto.ExpiresAt == nil {
+		return errors.New("nothing to update")
+	}
+
+	if dto.Tags != nil {
+		if err := validation.Validate(*dto.Tags, validation.Length(0, MaxTags), validation.Each(validation.Length(1, 50))); err != nil {
+			return errors.New("tags: " + err.Error())
+		}
+	}
+
+	if dto.Reminders != nil {
+		if err := validation.Validate(*dto.Reminders, validation.Length(0, MaxReminders), validation.Each(validReminder)); err != nil {
+			return errors.New("reminders: " + err.Error())
+		}
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Title, validation.NilOrNotEmpty, validation.Length(1, 255)),
+		validation.Field(&dto.Description, validation.Length(0, 10000)),
+		validation.Field(&dto.Priority, validation.NilOrNotEmpty, validation.In(Priorities...)),
+		validation.Field(&dto.Notes, validation.Length(0, 10000)),
+		validation.Field(&dto.ProjectID, validation.Min(int64(0))),
+		validation.Field(&dto.ExpiresAt, validation.NilOrNotEmpty),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTaskReqDTO digunakan untuk mengambil daftar task milik user
+// beserta filter, urutan, dan paginasi dari query parameter
 type GetTaskReqDTO struct {
-	UserID int64 `json:"id"`
+	UserID        int64       `json:"id"`
+	Status        string      `json:"status"`
+	Priority      []string    `json:"priority"`
+	Tags          []string    `json:"tag"`
+	TagMode       string      `json:"tag_mode"`
+	ProjectID     *int64      `json:"project_id"` // 0 berarti task tanpa project
+	ExpiresBefore *time.Time  `json:"expires_before"`
+	ExpiresAfter  *time.Time  `json:"expires_after"`
+	MinSnooze     *int        `json:"min_snooze_count"` // Hanya task yang sudah di-snooze minimal sebanyak ini
+	Sort          string      `json:"sort"`             // manual mengelompokkan task per pemilik, task milik user lebih dulu
+	Order         string      `json:"order"`
+	Page          int64       `json:"page"`
+	PerPage       int64       `json:"per_page"`
+	Cursor        string      `json:"cursor"`
+	After         *TaskCursor `json:"-"`
+}
+
+// TaskCursor adalah posisi terakhir (expires_at, id) untuk keyset pagination
+type TaskCursor struct {
+	ExpiresAt time.Time
+	ID        int64
+}
+
+// UseCursor mengembalikan true jika urutan daftar task mendukung keyset pagination
+func (dto *GetTaskReqDTO) UseCursor() bool {
+	return dto.Sort == "" || dto.Sort == "expires_at"
+}
+
+func (dto *GetTaskReqDTO) Validate() error {
+	if dto.Cursor != "" && !dto.UseCursor() {
+		return errors.New("cursor: only supported when sorting by expires_at")
+	}
+	if dto.Cursor != "" && dto.Page > 1 {
+		return errors.New("cursor: cannot be combined with page")
+	}
+
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Status, validation.In(Statuses...)),
+		validation.Field(&dto.Priority, validation.Each(validation.In(Priorities...))),
+		validation.Field(&dto.TagMode, validation.In("any", "all")),
+		validation.Field(&dto.ProjectID, validation.Min(int64(0))),
+		validation.Field(&dto.MinSnooze, validation.Min(1)),
+		validation.Field(&dto.Sort, validation.In("expires_at", "created_at", "title", "snooze_count", "manual")),
+		validation.Field(&dto.Order, validation.In("asc", "desc")),
+		validation.Field(&dto.Page, validation.Min(int64(1))),
+		validation.Field(&dto.PerPage, validation.Min(int64(1)), validation.Max(int64(100))),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteTaskReqDTO digunakan untuk memindahkan task ke trash
+type DeleteTaskReqDTO struct {
+	ID     int64    `json:"id"`
+	UserID int64    `json:"user_id"`
+	Audit  AuditDTO `json:"-"`
+}
+
+// RestoreTaskReqDTO digunakan untuk mengembalikan task dari trash
+type RestoreTaskReqDTO struct {
+	ID     int64    `json:"id"`
+	UserID int64    `json:"user_id"`
+	Audit  AuditDTO `json:"-"`
 }
 
 type ExpireTaskReqDTO struct {
 	ID int64 `json:"id"`
 }
 
+// TaskExpiredEventDTO adalah payload event ketika task berubah menjadi expired
+type TaskExpiredEventDTO struct {
+	ID             int64     `json:"id" db:"id"`
+	UserID         int64     `json:"user_id" db:"user_id"`
+	Title          string    `json:"title" db:"title"`
+	ExpiresAt      time.Time `json:"expires_at" db:"expires_at"`
+	PreviousStatus string    `json:"previous_status" db:"previous_status"`
+	UpdatedAt      time.Time `json:"-" db:"updated_at"`
+}
+
+// SearchTaskReqDTO digunakan untuk mencari task berdasarkan title dan description
+type SearchTaskReqDTO struct {
+	UserID  int64  `json:"user_id"`
+	Query   string `json:"q"`
+	Page    int64  `json:"page"`
+	PerPage int64  `json:"per_page"`
+}
+
+func (dto *SearchTaskReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Query, validation.Required, validation.Length(1, 100)),
+		validation.Field(&dto.Page, validation.Min(int64(1))),
+		validation.Field(&dto.PerPage, validation.Min(int64(1)), validation.Max(int64(100))),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SnippetWords adalah jumlah kata maksimal pada potongan description hasil pencarian
+const SnippetWords = 30
+
+// SearchTaskRespDTO adalah hasil pencarian task beserta skor relevansi.
+// Snippet dan DescriptionSnippet sudah di-escape sebagai HTML, kata kunci ditandai dengan <mark>.
+type SearchTaskRespDTO struct {
+	ID                 int64     `json:"id" db:"id"`
+	Title              string    `json:"title" db:"title"`
+	Description        string    `json:"-" db:"description"`
+	Priority           string    `json:"priority" db:"priority"`
+	Status             string    `json:"status" db:"status"`
+	ExpiresAt          time.Time `json:"expires_at" db:"expires_at"`
+	Rank               float64   `json:"rank" db:"rank"`
+	Permission         string    `json:"permission" db:"permission"` // Hak akses user yang mencari: owner, editor, atau viewer
+	Snippet            string    `json:"snippet" db:"-"`             // Title dengan kata kunci yang ditandai
+	DescriptionSnippet string    `json:"description_snippet" db:"-"` // Potongan description di sekitar kata kunci, kosong jika tidak cocok
+}
+
+// GetTaskListRespDTO adalah hasil daftar task beserta informasi paginasinya
+type GetTaskListRespDTO struct {
+	Tasks      []*GetTaskRespDTO
+	Total      int64 // Hanya dihitung pada halaman pertama, 0 pada halaman yang diambil dengan cursor
+	NextCursor string
+}
+
 type GetTaskRespDTO struct {
-	ID        int64     `json:"id" db:"id"`
-	Title     string    `json:"title" db:"title"`
-	Status    string    `json:"status" db:"status"`
-	ExpiresAt time.Time `json:"expires_at" db:"expires_at"`
+	ID             int64           `json:"id" db:"id"`
+	Title          string          `json:"title" db:"title"`
+	Description    string          `json:"description" db:"description"`
+	Priority       string          `json:"priority" db:"priority"`
+	Notes          string          `json:"notes" db:"notes"`
+	AutoFinish     bool            `json:"auto_finish" db:"auto_finish"`
+	ProjectID      *int64          `json:"project_id" db:"project_id"`
+	RecurrenceID   *int64          `json:"recurrence_id" db:"recurrence_id"`
+	Progress       *int            `json:"progress" db:"progress"`               // Persentase checklist selesai, null jika tidak ada checklist
+	TrackedSeconds int64           `json:"tracked_seconds" db:"tracked_seconds"` // Total waktu tercatat semua user, termasuk timer yang berjalan
+	Tags           []*TaskTagDTO   `json:"tags" db:"-"`
+	Reminders      ReminderOffsets `json:"reminders" db:"reminders"`
+	BlockedBy      TaskIDs         `json:"blocked_by" db:"blocked_by"` // Task yang masih pending dan harus selesai lebih dulu
+	Owner          TaskOwnerDTO    `json:"owner" db:"owner"`
+	Permission     string          `json:"permission,omitempty" db:"permission"` // Hak akses user yang meminta: owner, editor, atau viewer
+	Status         string          `json:"status" db:"status"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+	ExpiresAt      time.Time       `json:"expires_at" db:"expires_at"`
+	SnoozeCount    int             `json:"snooze_count" db:"snooze_count"`
+	Position       *string         `json:"position" db:"position"` // Rank urutan manual, null jika task belum pernah diurutkan
+	DeletedAt      *time.Time      `json:"deleted_at,omitempty" db:"deleted_at"`
+}
+
+// TaskOwnerDTO adalah pemilik task, berbeda dengan user yang meminta jika task dibagikan
+type TaskOwnerDTO struct {
+	ID    int64  `json:"id" db:"id"`
+	Name  string `json:"name" db:"name"`
+	Email string `json:"email" db:"email"`
+}
+
+// TaskTagDTO adalah tag yang terpasang pada task
+type TaskTagDTO struct {
+	TaskID int64  `json:"-" db:"task_id"`
+	ID     int64  `json:"id" db:"id"`
+	Name   string `json:"name" db:"name"`
+	Color  string `json:"color" db:"color"`
 }
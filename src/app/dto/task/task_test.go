@@ -0,0 +1,49 @@
+package task
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTaskPriority(t *testing.T) {
+	req := &CreateTaskReqDTO{Title: "rapat", ExpiresAt: time.Now().Add(time.Hour)}
+	assert.Nil(t, req.Validate(), "priority kosong memakai default medium")
+
+	for _, priority := range []string{"low", "medium", "high", "urgent"} {
+		req.Priority = priority
+		assert.Nil(t, req.Validate(), priority)
+	}
+
+	req.Priority = "critical"
+	assert.NotNil(t, req.Validate())
+}
+
+func TestCreateTaskDescriptionTooLong(t *testing.T) {
+	req := &CreateTaskReqDTO{Title: "rapat", Description: strings.Repeat("a", 10001), ExpiresAt: time.Now().Add(time.Hour)}
+	assert.NotNil(t, req.Validate())
+
+	req.Description = strings.Repeat("a", 10000)
+	req.Notes = strings.Repeat("b", 10001)
+	assert.NotNil(t, req.Validate())
+}
+
+func TestUpdateTaskPriority(t *testing.T) {
+	high, empty, invalid := "high", "", "critical"
+
+	assert.Nil(t, (&UpdateTaskReqDTO{Priority: &high}).Validate())
+	assert.NotNil(t, (&UpdateTaskReqDTO{Priority: &empty}).Validate())
+	assert.NotNil(t, (&UpdateTaskReqDTO{Priority: &invalid}).Validate())
+}
+
+func TestUpdateTaskClearDescription(t *testing.T) {
+	empty := ""
+	assert.Nil(t, (&UpdateTaskReqDTO{Description: &empty, Notes: &empty}).Validate())
+}
+
+func TestGetTaskListPriorityFilter(t *testing.T) {
+	assert.Nil(t, (&GetTaskReqDTO{Priority: []string{"high", "urgent"}}).Validate())
+	assert.NotNil(t, (&GetTaskReqDTO{Priority: []string{"high", "critical"}}).Validate())
+}
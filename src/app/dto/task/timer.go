@@ -0,0 +1,99 @@
+package task
+
+import (
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation"
+)
+
+// DateLayout adalah format tanggal pada query parameter laporan waktu
+const DateLayout = "2006-01-02"
+
+// MaxTimeReportDays adalah rentang hari maksimal pada satu laporan waktu
+const MaxTimeReportDays = 366
+
+// TimerReqDTO digunakan untuk memulai atau menghentikan timer user pada task.
+// Note saat stop kosong berarti catatan dari start tidak diubah.
+type TimerReqDTO struct {
+	TaskID int64  `json:"-"`
+	UserID int64  `json:"-"`
+	Note   string `json:"note"`
+}
+
+func (dto *TimerReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.Note, validation.Length(0, 1000)),
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TimeEntryRespDTO adalah satu catatan waktu pengerjaan task
+type TimeEntryRespDTO struct {
+	ID              int64      `json:"id" db:"id"`
+	TaskID          int64      `json:"task_id" db:"task_id"`
+	UserID          int64      `json:"user_id" db:"user_id"`
+	StartedAt       time.Time  `json:"started_at" db:"started_at"`
+	StoppedAt       *time.Time `json:"stopped_at" db:"stopped_at"` // null jika timer masih berjalan
+	DurationSeconds int64      `json:"duration_seconds" db:"duration_seconds"`
+	Note            string     `json:"note" db:"note"`
+}
+
+// GetTimeReportReqDTO digunakan untuk mengambil laporan waktu user per hari
+// dari tanggal From sampai To, keduanya inklusif
+type GetTimeReportReqDTO struct {
+	UserID int64     `json:"user_id"`
+	From   time.Time `json:"from"`
+	To     time.Time `json:"to"`
+}
+
+func (dto *GetTimeReportReqDTO) Validate() error {
+	if err := validation.ValidateStruct(
+		dto,
+		validation.Field(&dto.From, validation.Required),
+		validation.Field(&dto.To, validation.Required),
+	); err != nil {
+		return err
+	}
+
+	if dto.To.Before(dto.From) {
+		return errors.New("to: must not be before from")
+	}
+	if dto.To.Sub(dto.From) >= MaxTimeReportDays*24*time.Hour {
+		return errors.New("to: range must not exceed 366 days")
+	}
+	return nil
+}
+
+// TimeReportRowDTO adalah total waktu satu task pada satu hari dari repository
+type TimeReportRowDTO struct {
+	Day     time.Time `db:"day"`
+	TaskID  int64     `db:"task_id"`
+	Title   string    `db:"title"`
+	Seconds int64     `db:"seconds"`
+}
+
+// TimeReportRespDTO adalah laporan waktu user per hari pada rentang tanggal
+type TimeReportRespDTO struct {
+	From         string              `json:"from"`
+	To           string              `json:"to"`
+	TotalSeconds int64               `json:"total_seconds"`
+	Days         []*TimeReportDayDTO `json:"days"`
+}
+
+// TimeReportDayDTO adalah total waktu user pada satu hari beserta rinciannya per task
+type TimeReportDayDTO struct {
+	Date    string               `json:"date"`
+	Seconds int64                `json:"seconds"`
+	Tasks   []*TimeReportTaskDTO `json:"tasks"`
+}
+
+// TimeReportTaskDTO adalah total waktu satu task pada satu hari
+type TimeReportTaskDTO struct {
+	TaskID  int64  `json:"task_id"`
+	Title   string `json:"title"`
+	Seconds int64  `json:"seconds"`
+}
@@ -0,0 +1,234 @@
+package project
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/project"
+	shareDto "todo_list/src/app/dto/share"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrProjectNotFound dikembalikan ketika project tidak ada atau bukan milik user
+var ErrProjectNotFound = errors.New("project not found")
+
+// ProjectRepository mendefinisikan metode yang harus diimplementasikan
+type ProjectRepository interface {
+	GetProjectList(req *dto.GetProjectListReqDTO) ([]*dto.ProjectRespDTO, error)
+	GetProject(req *dto.GetProjectReqDTO) (*dto.ProjectRespDTO, error)
+	AddProject(req *dto.CreateProjectReqDTO) (*dto.ProjectRespDTO, error)
+	UpdateProject(req *dto.UpdateProjectReqDTO) (*dto.ProjectRespDTO, error)
+	DeleteProject(req *dto.DeleteProjectReqDTO) error
+	ReorderProject(req *dto.ReorderProjectReqDTO) error
+	ShareProject(req *shareDto.ShareReqDTO) (*shareDto.ShareRespDTO, error)
+	GetProjectShares(req *shareDto.GetShareListReqDTO) ([]*shareDto.ShareRespDTO, error)
+	RemoveProjectShare(req *shareDto.RemoveShareReqDTO) error
+}
+
+// Query SQL untuk berbagai operasi database
+const (
+	// ProjectColumns adalah kolom project beserta jumlah task per status, task di trash tidak dihitung
+	ProjectColumns = `id, name, color, archived, position, created_at, updated_at,
+		(SELECT COUNT(*) FROM public.tasks t WHERE t.project_id = projects.id AND t.deleted_at IS NULL AND t.status = 'pending') AS pending_count,
+		(SELECT COUNT(*) FROM public.tasks t WHERE t.project_id = projects.id AND t.deleted_at IS NULL AND t.status = 'in_progress') AS in_progress_count,
+		(SELECT COUNT(*) FROM public.tasks t WHERE t.project_id = projects.id AND t.deleted_at IS NULL AND t.status = 'done') AS done_count,
+		(SELECT COUNT(*) FROM public.tasks t WHERE t.project_id = projects.id AND t.deleted_at IS NULL AND t.status = 'expired') AS expired_count,
+		(SELECT COUNT(*) FROM public.tasks t WHERE t.project_id = projects.id AND t.deleted_at IS NULL AND t.status = 'cancelled') AS cancelled_count`
+
+	GetProjectList = `SELECT ` + ProjectColumns + ` FROM public.projects
+		WHERE user_id = $1 AND ($2 OR NOT archived)
+		ORDER BY position, id;`
+
+	GetProject = `SELECT ` + ProjectColumns + ` FROM public.projects WHERE id = $1 AND user_id = $2;`
+
+	// Project baru diletakkan di urutan paling akhir
+	AddProject = `INSERT INTO public.projects (user_id, name, color, position)
+		SELECT $1, $2, $3, COALESCE(MAX(position) + 1, 0) FROM public.projects WHERE user_id = $1
+		RETURNING ` + ProjectColumns + `;`
+
+	UpdateProject = `UPDATE public.projects SET
+			name = COALESCE($3, name),
+			color = COALESCE($4, color),
+			archived = COALESCE($5, archived),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + ProjectColumns + `;`
+
+	DeleteProject = `DELETE FROM public.projects WHERE id = $1 AND user_id = $2;`
+
+	ReorderProject = `UPDATE public.projects SET position = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2;`
+)
+
+// Struct untuk menyimpan statement yang telah diprepare
+var statement PreparedStatement
+
+type PreparedStatement struct {
+	getProjectList     *sqlx.Stmt
+	getProject         *sqlx.Stmt
+	addProject         *sqlx.Stmt
+	updateProject      *sqlx.Stmt
+	deleteProject      *sqlx.Stmt
+	reorderProject     *sqlx.Stmt
+	shareProject       *sqlx.Stmt
+	getProjectShares   *sqlx.Stmt
+	removeProjectShare *sqlx.Stmt
+}
+
+type projectRepo struct {
+	Connection *sqlx.DB
+}
+
+// NewProjectRepository menginisialisasi projectRepo dan menyiapkan prepared statement
+func NewProjectRepository(db *sqlx.DB) ProjectRepository {
+	repo := &projectRepo{
+		Connection: db,
+	}
+	InitPreparedStatement(repo)
+	return repo
+}
+
+// Preparex menyiapkan statement SQL yang telah diprepare
+func (p *projectRepo) Preparex(query string) *sqlx.Stmt {
+	statement, err := p.Connection.Preparex(query)
+	if err != nil {
+		log.Fatalf("Failed to preparex query: %s. Error: %s", query, err.Error())
+	}
+
+	return statement
+}
+
+// InitPreparedStatement menginisialisasi prepared statement untuk query tertentu
+func InitPreparedStatement(m *projectRepo) {
+	statement = PreparedStatement{
+		getProjectList:     m.Preparex(GetProjectList),
+		getProject:         m.Preparex(GetProject),
+		addProject:         m.Preparex(AddProject),
+		updateProject:      m.Preparex(UpdateProject),
+		deleteProject:      m.Preparex(DeleteProject),
+		reorderProject:     m.Preparex(ReorderProject),
+		shareProject:       m.Preparex(ShareProject),
+		getProjectShares:   m.Preparex(GetProjectShares),
+		removeProjectShare: m.Preparex(RemoveProjectShare),
+	}
+}
+
+// GetProjectList mengambil daftar project milik user sesuai urutan
+func (repo *projectRepo) GetProjectList(req *dto.GetProjectListReqDTO) ([]*dto.ProjectRespDTO, error) {
+	resp := []*dto.ProjectRespDTO{}
+	err := statement.getProjectList.Select(&resp, req.UserID, req.IncludeArchived)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetProject mengambil satu project milik user
+func (repo *projectRepo) GetProject(req *dto.GetProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	var resp dto.ProjectRespDTO
+	err := statement.getProject.Get(&resp, req.ID, req.UserID)
+	if err == sql.ErrNoRows {
+		return nil, ErrProjectNotFound
+	}
+	if err != nil {
+		log.Println("Failed to get project:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// AddProject menyimpan project baru milik user
+func (repo *projectRepo) AddProject(req *dto.CreateProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	var resp dto.ProjectRespDTO
+	err := statement.addProject.Get(&resp, req.UserID, req.Name, req.Color)
+	if err != nil {
+		log.Println("Failed to insert project:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateProject mengubah nama, warna, dan/atau status arsip project milik user
+func (repo *projectRepo) UpdateProject(req *dto.UpdateProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	var resp dto.ProjectRespDTO
+	err := statement.updateProject.Get(&resp, req.ID, req.UserID, req.Name, req.Color, req.Archived)
+	if err == sql.ErrNoRows {
+		return nil, ErrProjectNotFound
+	}
+	if err != nil {
+		log.Println("Failed to update project:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteProject menghapus project milik user, task di dalamnya menjadi tanpa project
+func (repo *projectRepo) DeleteProject(req *dto.DeleteProjectReqDTO) error {
+	result, err := statement.deleteProject.Exec(req.ID, req.UserID)
+	if err != nil {
+		log.Println("Failed to delete project:", err)
+		return err
+	}
+
+	// Tidak ada baris yang terhapus berarti project tidak ada atau bukan milik user
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrProjectNotFound
+	}
+
+	return nil
+}
+
+// ReorderProject menyimpan urutan baru project milik user dalam satu transaksi
+func (repo *projectRepo) ReorderProject(req *dto.ReorderProjectReqDTO) (err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	stmt := tx.Stmtx(statement.reorderProject)
+
+	var result sql.Result
+	for position, id := range req.ProjectIDs {
+		result, err = stmt.Exec(id, req.UserID, position)
+		if err != nil {
+			log.Println("Failed to reorder project:", err)
+			return err
+		}
+
+		var affected int64
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			err = ErrProjectNotFound
+			return err
+		}
+	}
+
+	return nil
+}
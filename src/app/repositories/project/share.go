@@ -0,0 +1,87 @@
+package project
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/share"
+)
+
+// ErrUserNotFound dikembalikan ketika tidak ada user lain dengan email yang diundang
+var ErrUserNotFound = errors.New("user not found")
+
+// ErrShareNotFound dikembalikan ketika project tidak dibagikan ke user yang dimaksud
+var ErrShareNotFound = errors.New("share not found")
+
+// Query SQL untuk berbagi project dengan user lain
+const (
+	// ShareProject mengundang user berdasarkan email, undangan ulang memperbarui permission
+	ShareProject = `WITH shared AS (
+			INSERT INTO public.project_shares (project_id, user_id, permission)
+			SELECT p.id, u.id, $4
+			FROM public.projects p JOIN public.users u ON LOWER(u.email) = LOWER($3) AND u.id <> p.user_id
+			WHERE p.id = $1 AND p.user_id = $2
+			ON CONFLICT (project_id, user_id) DO UPDATE SET permission = EXCLUDED.permission
+			RETURNING user_id, permission, created_at
+		)
+		SELECT s.user_id, u.name, u.email, s.permission, s.created_at
+		FROM shared s JOIN public.users u ON u.id = s.user_id;`
+
+	GetProjectShares = `SELECT s.user_id, u.name, u.email, s.permission, s.created_at
+		FROM public.project_shares s
+		JOIN public.users u ON u.id = s.user_id
+		JOIN public.projects p ON p.id = s.project_id
+		WHERE s.project_id = $1 AND p.user_id = $2
+		ORDER BY s.created_at, s.user_id;`
+
+	// RemoveProjectShare bisa dilakukan pemilik project atau kolaborator itu sendiri
+	RemoveProjectShare = `DELETE FROM public.project_shares s
+		USING public.projects p
+		WHERE p.id = s.project_id AND s.project_id = $1 AND s.user_id = $3 AND (p.user_id = $2 OR s.user_id = $2);`
+)
+
+// ShareProject membagikan project milik user ke user lain
+func (repo *projectRepo) ShareProject(req *dto.ShareReqDTO) (*dto.ShareRespDTO, error) {
+	var resp dto.ShareRespDTO
+	err := statement.shareProject.Get(&resp, req.ID, req.UserID, req.Email, req.Permission)
+	if err == sql.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		log.Println("Failed to share project:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetProjectShares mengambil daftar kolaborator project milik user
+func (repo *projectRepo) GetProjectShares(req *dto.GetShareListReqDTO) ([]*dto.ShareRespDTO, error) {
+	resp := []*dto.ShareRespDTO{}
+	err := statement.getProjectShares.Select(&resp, req.ID, req.UserID)
+	if err != nil {
+		log.Println("Failed to get project shares:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveProjectShare mencabut akses kolaborator dari project
+func (repo *projectRepo) RemoveProjectShare(req *dto.RemoveShareReqDTO) error {
+	result, err := statement.removeProjectShare.Exec(req.ID, req.UserID, req.ShareUserID)
+	if err != nil {
+		log.Println("Failed to delete project share:", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrShareNotFound
+	}
+
+	return nil
+}
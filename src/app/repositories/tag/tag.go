@@ -0,0 +1,160 @@
+package tag
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/tag"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ErrTagNotFound dikembalikan ketika tag tidak ada atau bukan milik user
+var ErrTagNotFound = errors.New("tag not found")
+
+// ErrTagAlreadyExist dikembalikan ketika user sudah memiliki tag dengan nama yang sama
+var ErrTagAlreadyExist = errors.New("tag already exist")
+
+// TagRepository mendefinisikan metode yang harus diimplementasikan
+type TagRepository interface {
+	GetTagList(req *dto.GetTagListReqDTO) ([]*dto.TagRespDTO, error)
+	AddTag(req *dto.CreateTagReqDTO) (*dto.TagRespDTO, error)
+	UpdateTag(req *dto.UpdateTagReqDTO) (*dto.TagRespDTO, error)
+	DeleteTag(req *dto.DeleteTagReqDTO) error
+}
+
+// Query SQL untuk berbagai operasi database
+const (
+	// TagColumns adalah kolom tag yang dikembalikan ke client
+	TagColumns = `id, name, color, created_at, updated_at,
+		(SELECT COUNT(*) FROM public.task_tags tt WHERE tt.tag_id = tags.id) AS task_count`
+
+	GetTagList = `SELECT ` + TagColumns + ` FROM public.tags WHERE user_id = $1 ORDER BY name;`
+
+	AddTag = `INSERT INTO public.tags (user_id, name, color) VALUES ($1, $2, $3)
+		RETURNING ` + TagColumns + `;`
+
+	UpdateTag = `UPDATE public.tags SET
+			name = COALESCE($3, name),
+			color = COALESCE($4, color),
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2
+		RETURNING ` + TagColumns + `;`
+
+	DeleteTag = `DELETE FROM public.tags WHERE id = $1 AND user_id = $2;`
+)
+
+// Struct untuk menyimpan statement yang telah diprepare
+var statement PreparedStatement
+
+type PreparedStatement struct {
+	getTagList *sqlx.Stmt
+	addTag     *sqlx.Stmt
+	updateTag  *sqlx.Stmt
+	deleteTag  *sqlx.Stmt
+}
+
+type tagRepo struct {
+	Connection *sqlx.DB
+}
+
+// NewTagRepository menginisialisasi tagRepo dan menyiapkan prepared statement
+func NewTagRepository(db *sqlx.DB) TagRepository {
+	repo := &tagRepo{
+		Connection: db,
+	}
+	InitPreparedStatement(repo)
+	return repo
+}
+
+// Preparex menyiapkan statement SQL yang telah diprepare
+func (p *tagRepo) Preparex(query string) *sqlx.Stmt {
+	statement, err := p.Connection.Preparex(query)
+	if err != nil {
+		log.Fatalf("Failed to preparex query: %s. Error: %s", query, err.Error())
+	}
+
+	return statement
+}
+
+// InitPreparedStatement menginisialisasi prepared statement untuk query tertentu
+func InitPreparedStatement(m *tagRepo) {
+	statement = PreparedStatement{
+		getTagList: m.Preparex(GetTagList),
+		addTag:     m.Preparex(AddTag),
+		updateTag:  m.Preparex(UpdateTag),
+		deleteTag:  m.Preparex(DeleteTag),
+	}
+}
+
+// GetTagList mengambil semua tag milik user beserta jumlah task yang memakainya
+func (repo *tagRepo) GetTagList(req *dto.GetTagListReqDTO) ([]*dto.TagRespDTO, error) {
+	resp := []*dto.TagRespDTO{}
+	err := statement.getTagList.Select(&resp, req.UserID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AddTag menyimpan tag baru milik user
+func (repo *tagRepo) AddTag(req *dto.CreateTagReqDTO) (*dto.TagRespDTO, error) {
+	var resp dto.TagRespDTO
+	err := statement.addTag.Get(&resp, req.UserID, req.Name, req.Color)
+	if isUniqueViolation(err) {
+		return nil, ErrTagAlreadyExist
+	}
+	if err != nil {
+		log.Println("Failed to insert tag:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateTag mengubah nama dan/atau warna tag milik user
+func (repo *tagRepo) UpdateTag(req *dto.UpdateTagReqDTO) (*dto.TagRespDTO, error) {
+	var resp dto.TagRespDTO
+	err := statement.updateTag.Get(&resp, req.ID, req.UserID, req.Name, req.Color)
+	if err == sql.ErrNoRows {
+		return nil, ErrTagNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, ErrTagAlreadyExist
+	}
+	if err != nil {
+		log.Println("Failed to update tag:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteTag menghapus tag milik user, tag juga terlepas dari semua task
+func (repo *tagRepo) DeleteTag(req *dto.DeleteTagReqDTO) error {
+	result, err := statement.deleteTag.Exec(req.ID, req.UserID)
+	if err != nil {
+		log.Println("Failed to delete tag:", err)
+		return err
+	}
+
+	// Tidak ada baris yang terhapus berarti tag tidak ada atau bukan milik user
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrTagNotFound
+	}
+
+	return nil
+}
+
+// isUniqueViolation memeriksa apakah error berasal dari pelanggaran unique (user_id, name)
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
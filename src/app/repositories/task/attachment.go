@@ -0,0 +1,136 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/task"
+)
+
+var (
+	// ErrAttachmentNotFound dikembalikan ketika lampiran tidak ada di task
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	// ErrQuotaExceeded dikembalikan ketika lampiran melebihi kuota penyimpanan user
+	ErrQuotaExceeded = errors.New("attachment quota exceeded")
+)
+
+// Query SQL untuk lampiran task
+const (
+	AttachmentColumns = `id, task_id, user_id, file_name, content_type, size_bytes, storage_key, created_at`
+
+	// LockAttachmentQuota menyerialkan unggahan milik satu user
+	// agar dua unggahan yang berjalan bersamaan tidak melewati kuota
+	LockAttachmentQuota = `SELECT pg_advisory_xact_lock(hashtext('task_attachments'), $1::int);`
+
+	GetAttachmentUsage = `SELECT COALESCE(SUM(size_bytes), 0) FROM public.task_attachments WHERE user_id = $1;`
+
+	// AddAttachment hanya menyimpan lampiran jika total ukuran lampiran user masih dalam kuota $7
+	AddAttachment = `INSERT INTO public.task_attachments (task_id, user_id, file_name, content_type, size_bytes, storage_key)
+		SELECT $1, $2, $3, $4, $5, $6
+		WHERE (SELECT COALESCE(SUM(size_bytes), 0) FROM public.task_attachments WHERE user_id = $2) + $5 <= $7
+		RETURNING ` + AttachmentColumns + `;`
+
+	GetAttachmentList = `SELECT ` + AttachmentColumns + ` FROM public.task_attachments
+		WHERE task_id = $1 ORDER BY id;`
+
+	GetAttachment = `SELECT ` + AttachmentColumns + ` FROM public.task_attachments
+		WHERE id = $1 AND task_id = $2;`
+
+	DeleteAttachment = `DELETE FROM public.task_attachments WHERE id = $1 AND task_id = $2
+		RETURNING ` + AttachmentColumns + `;`
+)
+
+// GetAttachmentUsage menghitung total ukuran lampiran yang diunggah user dalam byte
+func (repo *taskRepo) GetAttachmentUsage(userID int64) (int64, error) {
+	var used int64
+	err := statement.getAttachmentUsage.Get(&used, userID)
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	return used, nil
+}
+
+// AddAttachment menyimpan data lampiran selama total ukuran lampiran user tidak melebihi quota
+func (repo *taskRepo) AddAttachment(req *dto.AddAttachmentReqDTO, quota int64) (resp *dto.AttachmentRespDTO, err error) {
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return nil, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	if _, err = tx.Stmtx(statement.lockAttachmentQuota).Exec(req.UserID); err != nil {
+		log.Println("Failed to lock attachment quota:", err)
+		return nil, err
+	}
+
+	var attachment dto.AttachmentRespDTO
+	err = tx.Stmtx(statement.addAttachment).Get(&attachment,
+		req.TaskID, req.UserID, req.FileName, req.ContentType, req.Size, req.StorageKey, quota)
+	if err == sql.ErrNoRows {
+		err = ErrQuotaExceeded
+		return nil, err
+	}
+	if err != nil {
+		log.Println("Failed to insert attachment:", err)
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// GetAttachmentList mengambil semua lampiran task
+func (repo *taskRepo) GetAttachmentList(req *dto.GetAttachmentListReqDTO) ([]*dto.AttachmentRespDTO, error) {
+	resp := []*dto.AttachmentRespDTO{}
+	err := statement.getAttachmentList.Select(&resp, req.TaskID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetAttachment mengambil satu lampiran task
+func (repo *taskRepo) GetAttachment(req *dto.GetAttachmentReqDTO) (*dto.AttachmentRespDTO, error) {
+	var resp dto.AttachmentRespDTO
+	err := statement.getAttachment.Get(&resp, req.ID, req.TaskID)
+	if err == sql.ErrNoRows {
+		return nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteAttachment menghapus data lampiran task dan mengembalikannya
+// agar file di blob store bisa ikut dihapus
+func (repo *taskRepo) DeleteAttachment(req *dto.DeleteAttachmentReqDTO) (*dto.AttachmentRespDTO, error) {
+	var resp dto.AttachmentRespDTO
+	err := statement.deleteAttachment.Get(&resp, req.ID, req.TaskID)
+	if err == sql.ErrNoRows {
+		return nil, ErrAttachmentNotFound
+	}
+	if err != nil {
+		log.Println("Failed to delete attachment:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
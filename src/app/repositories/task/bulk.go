@@ -0,0 +1,125 @@
+package task
+
+import (
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/task"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ErrBulkAborted dikembalikan ketika bulk task mode atomic dibatalkan karena ada operasi yang gagal
+var ErrBulkAborted = errors.New("bulk operation aborted")
+
+// Savepoint untuk mode best_effort, operasi yang gagal dibatalkan tanpa membatalkan operasi lain
+const (
+	SavepointBulk         = `SAVEPOINT bulk_operation;`
+	RollbackSavepointBulk = `ROLLBACK TO SAVEPOINT bulk_operation;`
+	ReleaseSavepointBulk  = `RELEASE SAVEPOINT bulk_operation;`
+)
+
+// BulkTask menjalankan operasi bulk task dalam satu transaksi dan mengembalikan hasil per operasi
+// sesuai urutan req.Operations. Pada mode atomic, hasil tetap dikembalikan bersama ErrBulkAborted
+// jika ada operasi yang gagal dan seluruh perubahan dibatalkan.
+func (repo *taskRepo) BulkTask(req *dto.BulkTaskReqDTO) (resp []*dto.BulkTaskResultDTO, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return nil, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	resp = make([]*dto.BulkTaskResultDTO, 0, len(req.Operations))
+	for i, op := range req.Operations {
+		if req.Mode == dto.BulkModeAtomic {
+			result := bulkOperation(tx, op, req.Audit)
+			resp = append(resp, result)
+			if result.Err != nil {
+				resp = abortBulk(resp, req.Operations[i+1:])
+				err = ErrBulkAborted
+				return resp, err
+			}
+			continue
+		}
+
+		if _, err = tx.Exec(SavepointBulk); err != nil {
+			log.Println("Failed to create savepoint:", err)
+			return nil, err
+		}
+
+		result := bulkOperation(tx, op, req.Audit)
+		resp = append(resp, result)
+
+		if result.Err != nil {
+			_, err = tx.Exec(RollbackSavepointBulk)
+		} else {
+			_, err = tx.Exec(ReleaseSavepointBulk)
+		}
+		if err != nil {
+			log.Println("Failed to finish savepoint:", err)
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
+// bulkOperation menjalankan satu operasi bulk task di dalam transaksi yang sudah berjalan
+func bulkOperation(tx *sqlx.Tx, op *dto.BulkOperationDTO, audit dto.AuditDTO) *dto.BulkTaskResultDTO {
+	result := &dto.BulkTaskResultDTO{Index: op.Index, ID: op.ID, Op: op.Op, Status: dto.BulkStatusOK}
+
+	switch op.Op {
+	case dto.BulkFinish:
+		result.Transition, result.Err = transitionTask(tx, &dto.TransitionTaskReqDTO{
+			ID:     op.ID,
+			UserID: op.UserID,
+			Action: dto.TaskEventFinish,
+			From:   op.From,
+			To:     dto.StatusDone,
+			Audit:  audit,
+		})
+	case dto.BulkDelete:
+		result.Err = deleteTask(tx, &dto.DeleteTaskReqDTO{ID: op.ID, UserID: op.UserID, Audit: audit})
+	case dto.BulkRestore:
+		result.Task, result.Err = restoreTask(tx, &dto.RestoreTaskReqDTO{ID: op.ID, UserID: op.UserID, Audit: audit})
+	case dto.BulkSetProject:
+		result.Task, result.Err = updateTask(tx, &dto.UpdateTaskReqDTO{ID: op.ID, UserID: op.UserID, ProjectID: op.ProjectID, Audit: audit})
+	case dto.BulkSetPriority:
+		result.Task, result.Err = updateTask(tx, &dto.UpdateTaskReqDTO{ID: op.ID, UserID: op.UserID, Priority: op.Priority, Audit: audit})
+	default:
+		result.Err = errors.New("unknown bulk operation " + op.Op)
+	}
+
+	if result.Err != nil {
+		result.Status = dto.BulkStatusFailed
+	}
+	return result
+}
+
+// abortBulk menandai operasi yang sudah berhasil sebagai rolled_back
+// dan menambahkan operasi yang belum dijalankan sebagai skipped
+func abortBulk(resp []*dto.BulkTaskResultDTO, rest []*dto.BulkOperationDTO) []*dto.BulkTaskResultDTO {
+	for _, result := range resp {
+		if result.Status == dto.BulkStatusOK {
+			result.Status = dto.BulkStatusRolledBack
+			result.Task = nil
+			result.Transition = nil
+		}
+	}
+	for _, op := range rest {
+		resp = append(resp, &dto.BulkTaskResultDTO{Index: op.Index, ID: op.ID, Op: op.Op, Status: dto.BulkStatusSkipped})
+	}
+	return resp
+}
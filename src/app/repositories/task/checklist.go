@@ -0,0 +1,169 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/task"
+)
+
+// Query SQL untuk checklist task, kepemilikan task diverifikasi di use case
+const (
+	ChecklistColumns = `id, task_id, title, is_done, position, created_at, updated_at`
+
+	GetChecklist = `SELECT ` + ChecklistColumns + ` FROM public.task_checklist_items
+		WHERE task_id = $1 ORDER BY position, id;`
+
+	AddChecklistItem = `INSERT INTO public.task_checklist_items (task_id, title, position)
+		SELECT $1, $2, COALESCE(MAX(position) + 1, 0) FROM public.task_checklist_items WHERE task_id = $1
+		RETURNING ` + ChecklistColumns + `;`
+
+	CheckChecklistItem = `UPDATE public.task_checklist_items SET is_done = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND task_id = $2;`
+
+	ChecklistSummary = `SELECT COUNT(*) AS total, COUNT(*) FILTER (WHERE is_done) AS done
+		FROM public.task_checklist_items WHERE task_id = $1;`
+
+	// LockChecklist mengunci item checklist task agar jumlahnya bisa dibandingkan dengan urutan baru
+	LockChecklist = `SELECT id FROM public.task_checklist_items WHERE task_id = $1 FOR UPDATE;`
+
+	ReorderChecklistItem = `UPDATE public.task_checklist_items SET position = $3, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND task_id = $2;`
+
+	DeleteChecklistItem = `DELETE FROM public.task_checklist_items WHERE id = $1 AND task_id = $2;`
+)
+
+// GetChecklist mengambil item checklist task sesuai urutan
+func (repo *taskRepo) GetChecklist(req *dto.GetChecklistReqDTO) ([]*dto.ChecklistItemRespDTO, error) {
+	resp := []*dto.ChecklistItemRespDTO{}
+	err := statement.getChecklist.Select(&resp, req.TaskID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// AddChecklistItem menambahkan item checklist di urutan paling akhir
+func (repo *taskRepo) AddChecklistItem(req *dto.AddChecklistItemReqDTO) (*dto.ChecklistItemRespDTO, error) {
+	var resp dto.ChecklistItemRespDTO
+	err := statement.addChecklistItem.Get(&resp, req.TaskID, req.Title)
+	if err != nil {
+		log.Println("Failed to insert checklist item:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// CheckChecklistItem menandai item checklist selesai atau belum
+// dan mengembalikan ringkasan checklist task setelah perubahan
+func (repo *taskRepo) CheckChecklistItem(req *dto.CheckChecklistItemReqDTO) (*dto.ChecklistSummaryDTO, error) {
+	result, err := statement.checkItem.Exec(req.ID, req.TaskID, req.Done)
+	if err != nil {
+		log.Println("Failed to check checklist item:", err)
+		return nil, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, ErrItemNotFound
+	}
+
+	var summary dto.ChecklistSummaryDTO
+	err = statement.checklistSummary.Get(&summary, req.TaskID)
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// ErrIncompleteChecklist dikembalikan ketika urutan baru tidak berisi seluruh item checklist task
+var ErrIncompleteChecklist = errors.New("item_ids must contain every checklist item of the task")
+
+// ReorderChecklist menyimpan urutan baru item checklist dalam satu transaksi.
+// req.ItemIDs harus berisi seluruh item checklist task agar tidak ada posisi yang kembar.
+func (repo *taskRepo) ReorderChecklist(req *dto.ReorderChecklistReqDTO) (err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	var ids []int64
+	err = tx.Stmtx(statement.lockChecklist).Select(&ids, req.TaskID)
+	if err != nil {
+		log.Println("Failed to lock checklist items:", err)
+		return err
+	}
+	if len(ids) != len(req.ItemIDs) {
+		err = ErrIncompleteChecklist
+		return err
+	}
+
+	stmt, err := tx.Preparex(ReorderChecklistItem)
+	if err != nil {
+		log.Println("Failed to prepare reorderChecklistItem statement:", err)
+		return err
+	}
+	defer stmt.Close()
+
+	var result sql.Result
+	for position, id := range req.ItemIDs {
+		result, err = stmt.Exec(id, req.TaskID, position)
+		if err != nil {
+			log.Println("Failed to reorder checklist item:", err)
+			return err
+		}
+
+		var affected int64
+		affected, err = result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			err = ErrItemNotFound
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteChecklistItem menghapus item checklist dari task
+func (repo *taskRepo) DeleteChecklistItem(req *dto.DeleteChecklistItemReqDTO) error {
+	result, err := statement.deleteItem.Exec(req.ID, req.TaskID)
+	if err != nil {
+		log.Println("Failed to delete checklist item:", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrItemNotFound
+	}
+
+	return nil
+}
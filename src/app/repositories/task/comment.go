@@ -0,0 +1,102 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/task"
+)
+
+// ErrCommentNotFound dikembalikan ketika komentar tidak ada di task atau bukan milik user
+var ErrCommentNotFound = errors.New("comment not found")
+
+// Query SQL untuk komentar task
+const (
+	// CommentColumns adalah kolom komentar beserta penulisnya, c adalah alias tabel komentar
+	CommentColumns = `c.id, c.task_id, c.body, c.created_at, c.updated_at,
+		c.user_id AS "author.id", (SELECT u.name FROM public.users u WHERE u.id = c.user_id) AS "author.name"`
+
+	AddComment = `WITH c AS (
+			INSERT INTO public.task_comments (task_id, user_id, body) VALUES ($1, $2, $3)
+			RETURNING *
+		)
+		SELECT ` + CommentColumns + ` FROM c;`
+
+	GetCommentList = `SELECT ` + CommentColumns + ` FROM public.task_comments c
+		WHERE c.task_id = $1
+		ORDER BY c.created_at, c.id
+		LIMIT $2 OFFSET $3;`
+
+	CountCommentList = `SELECT COUNT(*) FROM public.task_comments WHERE task_id = $1;`
+
+	UpdateComment = `UPDATE public.task_comments c SET body = $4, updated_at = CURRENT_TIMESTAMP
+		WHERE c.id = $1 AND c.task_id = $2 AND c.user_id = $3
+		RETURNING ` + CommentColumns + `;`
+
+	DeleteComment = `DELETE FROM public.task_comments WHERE id = $1 AND task_id = $2 AND user_id = $3;`
+)
+
+// AddComment menyimpan komentar baru pada task
+func (repo *taskRepo) AddComment(req *dto.AddCommentReqDTO) (*dto.CommentRespDTO, error) {
+	var resp dto.CommentRespDTO
+	err := statement.addComment.Get(&resp, req.TaskID, req.UserID, req.Body)
+	if err != nil {
+		log.Println("Failed to insert comment:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetCommentList mengambil komentar task dari yang paling lama beserta jumlah totalnya
+func (repo *taskRepo) GetCommentList(req *dto.GetCommentListReqDTO) ([]*dto.CommentRespDTO, int64, error) {
+	var total int64
+	err := statement.countCommentList.Get(&total, req.TaskID)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	resp := []*dto.CommentRespDTO{}
+	err = statement.getCommentList.Select(&resp, req.TaskID, req.PerPage, (req.Page-1)*req.PerPage)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	return resp, total, nil
+}
+
+// UpdateComment mengubah isi komentar milik user
+func (repo *taskRepo) UpdateComment(req *dto.UpdateCommentReqDTO) (*dto.CommentRespDTO, error) {
+	var resp dto.CommentRespDTO
+	err := statement.updateComment.Get(&resp, req.ID, req.TaskID, req.UserID, req.Body)
+	if err == sql.ErrNoRows {
+		return nil, ErrCommentNotFound
+	}
+	if err != nil {
+		log.Println("Failed to update comment:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// DeleteComment menghapus komentar milik user
+func (repo *taskRepo) DeleteComment(req *dto.DeleteCommentReqDTO) error {
+	result, err := statement.deleteComment.Exec(req.ID, req.TaskID, req.UserID)
+	if err != nil {
+		log.Println("Failed to delete comment:", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrCommentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package task
+
+import (
+	"log"
+	dto "todo_list/src/app/dto/task"
+
+	"github.com/lib/pq"
+)
+
+// Query SQL untuk ketergantungan antar task
+const (
+	// LockDependencies menyerialkan perubahan ketergantungan milik satu user
+	// agar dua insert yang berjalan bersamaan tidak membentuk siklus
+	LockDependencies = `SELECT pg_advisory_xact_lock(hashtext('task_dependencies'), $1::int);`
+
+	CountOwnedTasks = `SELECT COUNT(*) FROM public.tasks
+		WHERE id = ANY($1) AND user_id = $2 AND deleted_at IS NULL;`
+
+	// DependencyPath memeriksa apakah task $1 sudah bergantung (langsung atau tidak) pada task $2
+	DependencyPath = `WITH RECURSIVE chain AS (
+			SELECT depends_on_id FROM public.task_dependencies WHERE task_id = $1
+			UNION
+			SELECT d.depends_on_id FROM public.task_dependencies d JOIN chain c ON d.task_id = c.depends_on_id
+		)
+		SELECT EXISTS (SELECT 1 FROM chain WHERE depends_on_id = $2);`
+
+	// TaskBlocked memeriksa apakah task $1 masih menunggu task yang belum selesai, sama seperti BlockedByColumn
+	TaskBlocked = `SELECT EXISTS (
+			SELECT 1 FROM public.task_dependencies d JOIN public.tasks b ON b.id = d.depends_on_id
+			WHERE d.task_id = $1 AND b.status IN ('pending', 'in_progress') AND b.deleted_at IS NULL
+		);`
+
+	AddDependency = `INSERT INTO public.task_dependencies (task_id, depends_on_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING;`
+
+	RemoveDependency = `DELETE FROM public.task_dependencies d
+		USING public.tasks t
+		WHERE t.id = d.task_id AND d.task_id = $1 AND d.depends_on_id = $2 AND t.user_id = $3;`
+)
+
+// AddDependency menandai task bergantung pada task lain milik user yang sama.
+// Ketergantungan yang membuat siklus ditolak dengan ErrDependencyCycle.
+func (repo *taskRepo) AddDependency(req *dto.AddDependencyReqDTO) (err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	if _, err = tx.Stmtx(statement.lockDependencies).Exec(req.UserID); err != nil {
+		log.Println("Failed to lock dependencies:", err)
+		return err
+	}
+
+	// Kedua task harus ada dan milik user
+	var owned int
+	err = tx.Stmtx(statement.countOwnedTasks).Get(&owned, pq.Array([]int64{req.TaskID, req.DependsOnID}), req.UserID)
+	if err != nil {
+		log.Println("Failed to check tasks:", err)
+		return err
+	}
+	if owned != 2 {
+		err = ErrTaskNotFound
+		return err
+	}
+
+	// Jika depends_on sudah bergantung pada task, edge baru akan menutup siklus
+	var cycle bool
+	if err = tx.Stmtx(statement.dependencyPath).Get(&cycle, req.DependsOnID, req.TaskID); err != nil {
+		log.Println("Failed to check dependency cycle:", err)
+		return err
+	}
+	if cycle {
+		err = ErrDependencyCycle
+		return err
+	}
+
+	if _, err = tx.Stmtx(statement.addDependency).Exec(req.TaskID, req.DependsOnID); err != nil {
+		log.Println("Failed to insert dependency:", err)
+		return err
+	}
+
+	return nil
+}
+
+// RemoveDependency menghapus ketergantungan task milik user
+func (repo *taskRepo) RemoveDependency(req *dto.RemoveDependencyReqDTO) error {
+	result, err := statement.removeDependency.Exec(req.TaskID, req.DependsOnID, req.UserID)
+	if err != nil {
+		log.Println("Failed to delete dependency:", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrDependencyNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package task
+
+import (
+	"database/sql"
+	"log"
+	dto "todo_list/src/app/dto/task"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Query SQL untuk riwayat perubahan task
+const (
+	// TaskSnapshotColumn adalah field task yang dicatat di riwayat dalam bentuk JSON
+	TaskSnapshotColumn = `jsonb_build_object(
+			'title', title, 'description', description, 'priority', priority, 'notes', notes,
+			'auto_finish', auto_finish, 'project_id', project_id, 'status', status,
+			'expires_at', expires_at, 'deleted_at', deleted_at,
+			'tags', (SELECT COALESCE(jsonb_agg(t.name ORDER BY t.name), '[]')
+				FROM public.task_tags tt JOIN public.tags t ON t.id = tt.tag_id WHERE tt.task_id = tasks.id)
+		)`
+
+	// TaskSnapshot mengunci task dan mengambil snapshot-nya
+	TaskSnapshot = `SELECT ` + TaskSnapshotColumn + ` FROM public.tasks WHERE id = $1 FOR UPDATE;`
+
+	// AddTaskEvent membandingkan snapshot lama ($4) dengan kondisi task saat ini
+	// dan hanya menyimpan field yang berubah
+	AddTaskEvent = `INSERT INTO public.task_events (task_id, actor_id, action, old_values, new_values, request_id)
+		SELECT $1, NULLIF($2::int, 0), $3,
+			(SELECT jsonb_object_agg(o.key, o.value) FROM jsonb_each($4::jsonb) o WHERE s.v -> o.key IS DISTINCT FROM o.value),
+			(SELECT jsonb_object_agg(n.key, n.value) FROM jsonb_each(s.v) n WHERE $4::jsonb -> n.key IS DISTINCT FROM n.value),
+			NULLIF($5, '')
+		FROM (SELECT ` + TaskSnapshotColumn + ` AS v FROM public.tasks WHERE id = $1) s;`
+
+	// ExpiredEvents mencatat task yang di-expire oleh sistem, dipakai sebagai CTE setelah "expired"
+	ExpiredEvents = `events AS (
+			INSERT INTO public.task_events (task_id, action, old_values, new_values)
+			SELECT id, 'expire', jsonb_build_object('status', previous_status), '{"status": "expired"}' FROM expired
+		)`
+
+	GetTaskHistory = `SELECT e.id, e.task_id, e.action, e.actor_id,
+			(SELECT u.name FROM public.users u WHERE u.id = e.actor_id) AS actor_name,
+			COALESCE(e.old_values, '{}') AS old_values, COALESCE(e.new_values, '{}') AS new_values,
+			e.request_id, e.created_at
+		FROM public.task_events e
+		WHERE e.task_id = $1
+		ORDER BY e.id DESC
+		LIMIT $2 OFFSET $3;`
+
+	CountTaskHistory = `SELECT COUNT(*) FROM public.task_events WHERE task_id = $1;`
+)
+
+// taskSnapshot mengunci task di dalam transaksi dan mengambil nilai field-nya sebelum diubah
+func taskSnapshot(tx *sqlx.Tx, id int64) (*string, error) {
+	var snapshot string
+	err := tx.Stmtx(statement.taskSnapshot).Get(&snapshot, id)
+	if err == sql.ErrNoRows {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		log.Println("Failed to get task snapshot:", err)
+		return nil, err
+	}
+
+	return &snapshot, nil
+}
+
+// addTaskEvent mencatat perubahan task di dalam transaksi.
+// old berisi snapshot sebelum perubahan, nil untuk task yang baru dibuat.
+func addTaskEvent(tx *sqlx.Tx, id int64, action string, audit dto.AuditDTO, old *string) error {
+	if _, err := tx.Stmtx(statement.addTaskEvent).Exec(id, audit.ActorID, action, old, audit.RequestID); err != nil {
+		log.Println("Failed to insert task event:", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetTaskHistory mengambil riwayat perubahan task dari yang terbaru beserta jumlah totalnya
+func (repo *taskRepo) GetTaskHistory(req *dto.GetTaskHistoryReqDTO) ([]*dto.TaskEventRespDTO, int64, error) {
+	var total int64
+	err := statement.countTaskHistory.Get(&total, req.TaskID)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	resp := []*dto.TaskEventRespDTO{}
+	err = statement.getTaskHistory.Select(&resp, req.TaskID, req.PerPage, (req.Page-1)*req.PerPage)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	return resp, total, nil
+}
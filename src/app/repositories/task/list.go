@@ -0,0 +1,157 @@
+package task
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	dto "todo_list/src/app/dto/task"
+
+	"github.com/lib/pq"
+)
+
+// Query dasar untuk daftar task, klausa WHERE dan ORDER BY disusun sesuai filter
+const (
+	GetTaskList = `SELECT ` + TaskColumns + `, ` + PermissionColumn + ` FROM public.tasks WHERE %s ORDER BY %s LIMIT %d OFFSET %d`
+
+	CountTaskList = `SELECT COUNT(*) FROM public.tasks WHERE %s`
+)
+
+// sortColumns adalah daftar kolom yang boleh digunakan untuk mengurutkan task
+var sortColumns = map[string]string{
+	"expires_at":   "expires_at",
+	"created_at":   "created_at",
+	"title":        "title",
+	"snooze_count": "snooze_count",
+	"manual":       "position",
+}
+
+// GetTaskList mengambil daftar task milik user dan task yang dibagikan ke user sesuai filter dan paginasi,
+// beserta jumlah total task yang cocok dengan filter. Total hanya dihitung jika request tidak memakai
+// cursor, halaman berikutnya pada keyset pagination mengembalikan total 0.
+func (repo *taskRepo) GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, int64, error) {
+	where, args := buildTaskListFilter(req)
+
+	// COUNT(*) membaca seluruh task yang cocok, sehingga tidak diulang di setiap halaman cursor
+	var total int64
+	if req.After == nil {
+		err := repo.Connection.Get(&total, fmt.Sprintf(CountTaskList, where), args...)
+		if err != nil {
+			log.Println(err)
+			return nil, 0, err
+		}
+	}
+
+	// Keyset pagination tidak memakai OFFSET, posisi halaman ditentukan oleh cursor
+	offset := (req.Page - 1) * req.PerPage
+	if req.After != nil {
+		where, args = buildTaskListCursor(req, where, args)
+		offset = 0
+	}
+
+	query := fmt.Sprintf(GetTaskList, where, buildTaskListOrder(req), req.PerPage, offset)
+
+	resp := []*dto.GetTaskRespDTO{}
+	err := repo.Connection.Select(&resp, query, args...)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	if err := attachTags(statement.getTaskTags, resp...); err != nil {
+		return nil, 0, err
+	}
+
+	return resp, total, nil
+}
+
+// buildTaskListFilter menyusun klausa WHERE beserta argumennya dari filter request
+func buildTaskListFilter(req *dto.GetTaskReqDTO) (string, []interface{}) {
+	conds := []string{TaskAccessFilter, "deleted_at IS NULL"}
+	args := []interface{}{req.UserID}
+
+	if req.Status != "" {
+		args = append(args, req.Status)
+		conds = append(conds, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	if len(req.Priority) > 0 {
+		args = append(args, pq.Array(req.Priority))
+		conds = append(conds, fmt.Sprintf("priority = ANY($%d)", len(args)))
+	}
+
+	if req.ProjectID != nil {
+		if *req.ProjectID == 0 {
+			conds = append(conds, "project_id IS NULL")
+		} else {
+			args = append(args, *req.ProjectID)
+			conds = append(conds, fmt.Sprintf("project_id = $%d", len(args)))
+		}
+	}
+
+	if len(req.Tags) > 0 {
+		args = append(args, pq.Array(req.Tags))
+		// Tag dicocokkan berdasarkan nama karena tag pada task yang dibagikan milik pemilik task
+		tagFilter := fmt.Sprintf(`id IN (SELECT tt.task_id FROM public.task_tags tt
+			JOIN public.tags t ON t.id = tt.tag_id
+			WHERE t.name = ANY($%d)`, len(args))
+
+		// Mode "all" mengharuskan task memiliki semua tag yang diminta
+		if req.TagMode == "all" {
+			args = append(args, len(req.Tags))
+			tagFilter += fmt.Sprintf(" GROUP BY tt.task_id HAVING COUNT(DISTINCT t.id) = $%d", len(args))
+		}
+		conds = append(conds, tagFilter+")")
+	}
+
+	if req.ExpiresBefore != nil {
+		args = append(args, *req.ExpiresBefore)
+		conds = append(conds, fmt.Sprintf("expires_at < $%d", len(args)))
+	}
+
+	if req.ExpiresAfter != nil {
+		args = append(args, *req.ExpiresAfter)
+		conds = append(conds, fmt.Sprintf("expires_at > $%d", len(args)))
+	}
+
+	if req.MinSnooze != nil {
+		args = append(args, *req.MinSnooze)
+		conds = append(conds, fmt.Sprintf("snooze_count >= $%d", len(args)))
+	}
+
+	return strings.Join(conds, " AND "), args
+}
+
+// buildTaskListCursor menambahkan kondisi keyset (expires_at, id) setelah cursor
+// sesuai arah urutan, memakai index tasks(user_id, expires_at, id)
+func buildTaskListCursor(req *dto.GetTaskReqDTO, where string, args []interface{}) (string, []interface{}) {
+	op := ">"
+	if req.Order == "desc" {
+		op = "<"
+	}
+
+	args = append(args, req.After.ExpiresAt, req.After.ID)
+	where += fmt.Sprintf(" AND (expires_at, id) %s ($%d, $%d)", op, len(args)-1, len(args))
+
+	return where, args
+}
+
+// buildTaskListOrder menyusun klausa ORDER BY, id dipakai sebagai pemecah urutan yang sama
+func buildTaskListOrder(req *dto.GetTaskReqDTO) string {
+	column, ok := sortColumns[req.Sort]
+	if !ok {
+		column = sortColumns["expires_at"]
+	}
+
+	order := "ASC"
+	if req.Order == "desc" {
+		order = "DESC"
+	}
+
+	// Urutan manual disimpan per pemilik, sehingga task dikelompokkan per pemilik
+	// (task milik user lebih dulu) dan task yang belum diurutkan selalu di akhir kelompoknya
+	if req.Sort == "manual" {
+		return fmt.Sprintf("tasks.user_id <> $1, tasks.user_id, %s %s NULLS LAST, id %s", column, order, order)
+	}
+
+	return fmt.Sprintf("%s %s, id %s", column, order, order)
+}
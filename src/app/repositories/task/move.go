@@ -0,0 +1,215 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/task"
+	"todo_list/src/infra/rank"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ErrInvalidMove dikembalikan ketika task after tidak berada di atas task before pada urutan manual
+var ErrInvalidMove = errors.New("after task is not ordered above before task")
+
+// Query SQL untuk urutan manual task. Urutan disimpan per pemilik task pada kolom
+// position (collation "C") sebagai rank fractional indexing dari package rank.
+const (
+	// LockTaskPositions mencegah dua perpindahan milik user yang sama menghasilkan rank kembar
+	LockTaskPositions = `SELECT pg_advisory_xact_lock(hashtext('task_positions'), $1::int);`
+
+	MaxTaskPosition = `SELECT COALESCE(MAX(position), '') FROM public.tasks WHERE user_id = $1;`
+
+	// UnpositionedTasks mengambil task yang belum pernah diurutkan, tampil di akhir daftar sesuai id
+	UnpositionedTasks = `SELECT id FROM public.tasks WHERE user_id = $1 AND position IS NULL ORDER BY id;`
+
+	// OrderedTasks mengambil seluruh task user, termasuk yang ada di trash, sesuai urutan manual saat ini
+	OrderedTasks = `SELECT id FROM public.tasks WHERE user_id = $1 ORDER BY position NULLS LAST, id;`
+
+	SetTaskPositions = `UPDATE public.tasks t SET position = p.position
+		FROM unnest($1::int[], $2::text[]) AS p(id, position)
+		WHERE t.id = p.id;`
+
+	GetTaskPosition = `SELECT position FROM public.tasks
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;`
+
+	// NextTaskPosition dan PrevTaskPosition mencari rank tetangga tanpa menghitung task yang dipindahkan
+	NextTaskPosition = `SELECT COALESCE(MIN(position), '') FROM public.tasks
+		WHERE user_id = $1 AND id <> $2 AND position > $3;`
+
+	PrevTaskPosition = `SELECT COALESCE(MAX(position), '') FROM public.tasks
+		WHERE user_id = $1 AND id <> $2 AND position < $3;`
+
+	MoveTask = `UPDATE public.tasks SET position = $2
+		WHERE id = $1
+		RETURNING ` + TaskColumns + `;`
+)
+
+// MoveTask memindahkan task milik user ke antara task req.After dan req.Before.
+// Hanya task yang dipindahkan yang diubah, kecuali rank sudah terlalu panjang
+// sehingga seluruh urutan user di-rebalance.
+func (repo *taskRepo) MoveTask(req *dto.MoveTaskReqDTO) (resp *dto.GetTaskRespDTO, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return nil, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	if _, err = tx.Stmtx(statement.lockTaskPositions).Exec(req.UserID); err != nil {
+		log.Println("Failed to lock task positions:", err)
+		return nil, err
+	}
+
+	if err = positionTasks(tx, req.UserID); err != nil {
+		return nil, err
+	}
+
+	position, err := movePosition(tx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rank terlalu panjang setelah banyak perpindahan di celah yang sama, urutan dibuat ulang sekali
+	if len(position) > rank.MaxLength {
+		if err = rebalanceTasks(tx, req.UserID); err != nil {
+			return nil, err
+		}
+		if position, err = movePosition(tx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp = &dto.GetTaskRespDTO{}
+	err = tx.Stmtx(statement.moveTask).Get(resp, req.ID, position)
+	if err == sql.ErrNoRows {
+		err = ErrTaskNotFound
+		return nil, err
+	}
+	if err != nil {
+		log.Println("Failed to move task:", err)
+		return nil, err
+	}
+
+	if err = attachTags(tx.Stmtx(statement.getTaskTags), resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// positionTasks memberi rank pada task user yang belum pernah diurutkan, ditambahkan
+// di akhir daftar sesuai id agar urutan yang terlihat tidak berubah
+func positionTasks(tx *sqlx.Tx, userID int64) error {
+	var ids []int64
+	if err := tx.Stmtx(statement.unpositionedTasks).Select(&ids, userID); err != nil {
+		log.Println("Failed to get unpositioned tasks:", err)
+		return err
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	var last string
+	if err := tx.Stmtx(statement.maxTaskPosition).Get(&last, userID); err != nil {
+		log.Println("Failed to get last task position:", err)
+		return err
+	}
+
+	positions, err := rank.BetweenN(last, "", len(ids))
+	if err != nil {
+		log.Println("Failed to generate task positions:", err)
+		return err
+	}
+	if len(positions[len(positions)-1]) > rank.MaxLength {
+		return rebalanceTasks(tx, userID)
+	}
+
+	return setTaskPositions(tx, ids, positions)
+}
+
+// rebalanceTasks membuat ulang rank seluruh task user dengan jarak yang sama tanpa mengubah urutannya
+func rebalanceTasks(tx *sqlx.Tx, userID int64) error {
+	var ids []int64
+	if err := tx.Stmtx(statement.orderedTasks).Select(&ids, userID); err != nil {
+		log.Println("Failed to get ordered tasks:", err)
+		return err
+	}
+
+	return setTaskPositions(tx, ids, rank.Spread(len(ids)))
+}
+
+func setTaskPositions(tx *sqlx.Tx, ids []int64, positions []string) error {
+	if _, err := tx.Stmtx(statement.setTaskPositions).Exec(pq.Array(ids), pq.Array(positions)); err != nil {
+		log.Println("Failed to set task positions:", err)
+		return err
+	}
+	return nil
+}
+
+// movePosition menghitung rank baru di antara task after dan before. Jika hanya salah
+// satu yang diisi, tetangga lainnya adalah task yang saat ini bersebelahan dengannya.
+func movePosition(tx *sqlx.Tx, req *dto.MoveTaskReqDTO) (string, error) {
+	var lower, upper string
+	var err error
+
+	if req.After != nil {
+		if lower, err = taskPosition(tx, *req.After, req.UserID); err != nil {
+			return "", err
+		}
+	}
+	if req.Before != nil {
+		if upper, err = taskPosition(tx, *req.Before, req.UserID); err != nil {
+			return "", err
+		}
+	}
+
+	switch {
+	case req.Before == nil:
+		err = tx.Stmtx(statement.nextTaskPosition).Get(&upper, req.UserID, req.ID, lower)
+	case req.After == nil:
+		err = tx.Stmtx(statement.prevTaskPosition).Get(&lower, req.UserID, req.ID, upper)
+	}
+	if err != nil {
+		log.Println("Failed to get neighbour task position:", err)
+		return "", err
+	}
+
+	position, err := rank.Between(lower, upper)
+	if errors.Is(err, rank.ErrInvalidRange) {
+		return "", ErrInvalidMove
+	}
+	if err != nil {
+		log.Println("Failed to generate task position:", err)
+		return "", err
+	}
+	return position, nil
+}
+
+// taskPosition mengambil rank task tetangga, positionTasks sudah memastikan rank tidak kosong
+func taskPosition(tx *sqlx.Tx, id int64, userID int64) (string, error) {
+	var position string
+	err := tx.Stmtx(statement.getTaskPosition).Get(&position, id, userID)
+	if err == sql.ErrNoRows {
+		return "", ErrTaskNotFound
+	}
+	if err != nil {
+		log.Println("Failed to get task position:", err)
+		return "", err
+	}
+	return position, nil
+}
@@ -0,0 +1,198 @@
+package task
+
+import (
+	"database/sql"
+	"log"
+	dto "todo_list/src/app/dto/task"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Query SQL untuk task berulang
+const (
+	// RecurrenceColumns adalah kolom seri berulang yang dikembalikan ke client
+	RecurrenceColumns = `r.id, r.rrule, r.timezone, r.dtstart, r.title, r.description, r.priority, r.notes,
+		r.active, r.created_at, r.updated_at`
+
+	AddRecurrence = `INSERT INTO public.task_recurrences
+			(user_id, rrule, timezone, dtstart, title, description, priority, notes, auto_finish, project_id)
+		VALUES ($1, $2, COALESCE(NULLIF($3, ''), 'UTC'), $4, $5, $6, COALESCE(NULLIF($7, ''), 'medium'), $8, $9,
+			(SELECT p.id FROM public.projects p WHERE p.id = $10 AND p.user_id = $1))
+		RETURNING id;`
+
+	SetLastTask = `UPDATE public.task_recurrences SET last_task_id = $2 WHERE id = $1;`
+
+	GetTaskRecurrence = `SELECT r.id, r.rrule, r.timezone, r.dtstart, r.active, r.last_task_id,
+			t.expires_at AS task_expires_at
+		FROM public.task_recurrences r JOIN public.tasks t ON t.recurrence_id = r.id
+		WHERE t.id = $1;`
+
+	// AddNextInstance hanya membuat instance jika prev masih instance terbaru dari seri yang aktif,
+	// sehingga finish dan expire pada instance yang sama tidak membuat instance ganda
+	AddNextInstance = `INSERT INTO public.tasks
+			(user_id, title, description, priority, notes, auto_finish, project_id, recurrence_id, expires_at)
+		SELECT user_id, title, description, priority, notes, auto_finish, project_id, id, $3
+		FROM public.task_recurrences
+		WHERE id = $1 AND active AND last_task_id = $2
+		FOR UPDATE
+		RETURNING id;`
+
+	CopyTaskTags = `INSERT INTO public.task_tags (task_id, tag_id)
+		SELECT $2, tag_id FROM public.task_tags WHERE task_id = $1;`
+
+	GetRecurrence = `SELECT ` + RecurrenceColumns + `
+		FROM public.task_recurrences r JOIN public.tasks t ON t.recurrence_id = r.id
+		WHERE t.id = $1 AND t.user_id = $2 AND t.deleted_at IS NULL;`
+
+	UpdateRecurrence = `UPDATE public.task_recurrences r SET
+			rrule = COALESCE($3, r.rrule),
+			timezone = COALESCE($4, r.timezone),
+			title = COALESCE($5, r.title),
+			description = COALESCE($6, r.description),
+			priority = COALESCE($7, r.priority),
+			notes = COALESCE($8, r.notes),
+			updated_at = CURRENT_TIMESTAMP
+		FROM public.tasks t
+		WHERE t.recurrence_id = r.id AND t.id = $1 AND t.user_id = $2 AND t.deleted_at IS NULL
+		RETURNING ` + RecurrenceColumns + `;`
+
+	StopRecurrence = `UPDATE public.task_recurrences r SET active = false, updated_at = CURRENT_TIMESTAMP
+		FROM public.tasks t
+		WHERE t.recurrence_id = r.id AND t.id = $1 AND t.user_id = $2 AND t.deleted_at IS NULL;`
+)
+
+// addRecurrence menyimpan template seri berulang di dalam transaksi AddTask
+func addRecurrence(tx *sqlx.Tx, req *dto.CreateTaskReqDTO) (*int64, error) {
+	var id int64
+	err := tx.Stmtx(statement.addRecurrence).QueryRowx(
+		req.UserID, req.Recurrence, req.Timezone, req.ExpiresAt, req.Title, req.Description, req.Priority,
+		req.Notes, req.AutoFinish, req.ProjectID).Scan(&id)
+	if err != nil {
+		log.Println("Failed to insert recurrence:", err)
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// GetTaskRecurrence mengambil seri berulang dari sebuah instance task.
+// Mengembalikan nil jika task bukan task berulang.
+func (repo *taskRepo) GetTaskRecurrence(taskID int64) (*dto.TaskRecurrenceDTO, error) {
+	var resp dto.TaskRecurrenceDTO
+	err := statement.getTaskRecurrence.Get(&resp, taskID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		log.Println("Failed to get task recurrence:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// AddNextInstance membuat instance berikutnya dari template seri beserta tag dan pengingat
+// instance sebelumnya.
+// Mengembalikan 0 jika instance berikutnya sudah dibuat atau seri sudah dihentikan.
+func (repo *taskRepo) AddNextInstance(req *dto.NextInstanceReqDTO) (id int64, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return 0, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	err = tx.Stmtx(statement.addNextInstance).QueryRowx(req.RecurrenceID, req.PrevTaskID, req.ExpiresAt).Scan(&id)
+	if err == sql.ErrNoRows {
+		err = nil
+		return 0, nil
+	}
+	if err != nil {
+		log.Println("Failed to insert next instance:", err)
+		return 0, err
+	}
+
+	if _, err = tx.Stmtx(statement.copyTaskTags).Exec(req.PrevTaskID, id); err != nil {
+		log.Println("Failed to copy task tags:", err)
+		return 0, err
+	}
+
+	if _, err = tx.Stmtx(statement.copyTaskReminders).Exec(req.PrevTaskID, id); err != nil {
+		log.Println("Failed to copy task reminders:", err)
+		return 0, err
+	}
+
+	if _, err = tx.Stmtx(statement.setLastTask).Exec(req.RecurrenceID, id); err != nil {
+		log.Println("Failed to update recurrence:", err)
+		return 0, err
+	}
+
+	// Instance berikutnya dibuat oleh sistem sehingga tidak memiliki pelaku
+	if err = addTaskEvent(tx, id, dto.TaskEventCreate, dto.AuditDTO{}, nil); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetRecurrence mengambil seri berulang dari task milik user
+func (repo *taskRepo) GetRecurrence(req *dto.GetRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error) {
+	var resp dto.RecurrenceRespDTO
+	err := statement.getRecurrence.Get(&resp, req.TaskID, req.UserID)
+	if err == sql.ErrNoRows {
+		return nil, ErrRecurrenceNotFound
+	}
+	if err != nil {
+		log.Println("Failed to get recurrence:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateRecurrence mengubah aturan dan template seri berulang dari task milik user
+func (repo *taskRepo) UpdateRecurrence(req *dto.UpdateRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error) {
+	var resp dto.RecurrenceRespDTO
+	err := statement.updateRecurrence.Get(&resp, req.TaskID, req.UserID,
+		req.Recurrence, req.Timezone, req.Title, req.Description, req.Priority, req.Notes)
+	if err == sql.ErrNoRows {
+		return nil, ErrRecurrenceNotFound
+	}
+	if err != nil {
+		log.Println("Failed to update recurrence:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// StopRecurrence menghentikan seri berulang dari task milik user
+func (repo *taskRepo) StopRecurrence(req *dto.StopRecurrenceReqDTO) error {
+	result, err := statement.stopRecurrence.Exec(req.TaskID, req.UserID)
+	if err != nil {
+		log.Println("Failed to stop recurrence:", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrRecurrenceNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package task
+
+import (
+	"log"
+	dto "todo_list/src/app/dto/task"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Query SQL untuk pengingat sebelum task expired
+const (
+	AddReminders = `INSERT INTO public.task_reminders (task_id, offset_seconds, remind_at)
+		SELECT t.id, o.seconds, t.expires_at - o.seconds * INTERVAL '1 second'
+		FROM public.tasks t, unnest($2::int[]) AS o(seconds)
+		WHERE t.id = $1
+		ON CONFLICT (task_id, offset_seconds) DO NOTHING;`
+
+	// KeepReminders menghapus pengingat yang offset-nya tidak ada di daftar baru,
+	// pengingat yang tetap ada tidak dikirim ulang
+	KeepReminders = `DELETE FROM public.task_reminders
+		WHERE task_id = $1 AND NOT (offset_seconds = ANY($2::int[]));`
+
+	// RescheduleReminders menghitung ulang waktu pengingat setelah expires_at berubah.
+	// Pengingat yang waktunya bergeser ke masa depan akan dikirim lagi.
+	RescheduleReminders = `UPDATE public.task_reminders r SET
+			remind_at = t.expires_at - r.offset_seconds * INTERVAL '1 second',
+			sent_at = CASE
+				WHEN t.expires_at - r.offset_seconds * INTERVAL '1 second' > CURRENT_TIMESTAMP THEN NULL
+				ELSE r.sent_at
+			END,
+			claimed_at = CASE
+				WHEN t.expires_at - r.offset_seconds * INTERVAL '1 second' > CURRENT_TIMESTAMP THEN NULL
+				ELSE r.claimed_at
+			END
+		FROM public.tasks t
+		WHERE t.id = r.task_id AND r.task_id = $1;`
+
+	GetTaskReminders = `SELECT COALESCE(ARRAY_AGG(offset_seconds ORDER BY offset_seconds), '{}')
+		FROM public.task_reminders WHERE task_id = $1;`
+
+	// CopyTaskReminders memasang offset pengingat instance sebelumnya ke instance berikutnya
+	CopyTaskReminders = `INSERT INTO public.task_reminders (task_id, offset_seconds, remind_at)
+		SELECT t.id, r.offset_seconds, t.expires_at - r.offset_seconds * INTERVAL '1 second'
+		FROM public.task_reminders r JOIN public.tasks t ON t.id = $2
+		WHERE r.task_id = $1;`
+
+	// ClaimDueReminders mengklaim pengingat yang jatuh tempo dengan lease ($2 detik) dan
+	// mengembalikannya dalam satu statement, sehingga pengingat yang sama tidak diambil dua kali
+	// oleh beberapa instance scheduler. Klaim yang lease-nya habis tanpa ditandai terkirim,
+	// misalnya karena service mati sebelum publish, diambil lagi.
+	ClaimDueReminders = `UPDATE public.task_reminders r SET claimed_at = CURRENT_TIMESTAMP
+		FROM public.tasks t
+		WHERE t.id = r.task_id AND r.sent_at IS NULL AND r.id IN (
+			SELECT dr.id FROM public.task_reminders dr
+			JOIN public.tasks dt ON dt.id = dr.task_id
+			WHERE dr.sent_at IS NULL AND dr.remind_at <= CURRENT_TIMESTAMP
+				AND (dr.claimed_at IS NULL OR dr.claimed_at < CURRENT_TIMESTAMP - $2::int * INTERVAL '1 second')
+				AND dt.status IN ('pending', 'in_progress') AND dt.deleted_at IS NULL AND dt.expires_at > CURRENT_TIMESTAMP
+			ORDER BY dr.remind_at
+			LIMIT $1
+			FOR UPDATE OF dr SKIP LOCKED
+		)
+		RETURNING r.id, r.task_id, t.user_id, t.title, r.offset_seconds, r.remind_at, t.expires_at;`
+
+	// MarkReminderSent hanya berlaku untuk klaim yang masih ada, pengingat yang dijadwalkan
+	// ulang selama dikirim tetap menunggu waktu barunya
+	MarkReminderSent = `UPDATE public.task_reminders SET sent_at = CURRENT_TIMESTAMP, claimed_at = NULL
+		WHERE id = $1 AND sent_at IS NULL AND claimed_at IS NOT NULL;`
+
+	ReleaseReminder = `UPDATE public.task_reminders SET claimed_at = NULL WHERE id = $1 AND sent_at IS NULL;`
+)
+
+// addReminders menyimpan pengingat task di dalam transaksi
+func addReminders(tx *sqlx.Tx, taskID int64, offsets []int64) error {
+	if len(offsets) == 0 {
+		return nil
+	}
+
+	if _, err := tx.Stmtx(statement.addReminders).Exec(taskID, pq.Array(offsets)); err != nil {
+		log.Println("Failed to insert reminders:", err)
+		return err
+	}
+
+	return nil
+}
+
+// replaceReminders mengganti pengingat task dengan daftar offset yang baru
+func replaceReminders(tx *sqlx.Tx, taskID int64, offsets []int64) error {
+	if _, err := tx.Stmtx(statement.keepReminders).Exec(taskID, pq.Array(offsets)); err != nil {
+		log.Println("Failed to delete reminders:", err)
+		return err
+	}
+
+	return addReminders(tx, taskID, offsets)
+}
+
+// ClaimDueReminders mengambil pengingat yang jatuh tempo, maksimal sebanyak limit,
+// dan mengklaimnya selama dto.ReminderClaimLease
+func (repo *taskRepo) ClaimDueReminders(limit int) ([]*dto.TaskReminderEventDTO, error) {
+	var resp []*dto.TaskReminderEventDTO
+	err := statement.claimDueReminders.Select(&resp, limit, int(dto.ReminderClaimLease.Seconds()))
+	if err != nil {
+		log.Println("Failed to claim due reminders:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// MarkReminderSent menandai pengingat yang sudah berhasil dikirim
+func (repo *taskRepo) MarkReminderSent(id int64) error {
+	if _, err := statement.markReminderSent.Exec(id); err != nil {
+		log.Println("Failed to mark reminder sent:", err)
+		return err
+	}
+
+	return nil
+}
+
+// ReleaseReminder melepas klaim pengingat agar diambil lagi pada putaran berikutnya
+func (repo *taskRepo) ReleaseReminder(id int64) error {
+	if _, err := statement.releaseReminder.Exec(id); err != nil {
+		log.Println("Failed to release reminder:", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package task
+
+import (
+	"log"
+	"strings"
+	dto "todo_list/src/app/dto/task"
+	"todo_list/src/infra/helper"
+)
+
+// Query SQL untuk full-text search, $2 adalah tsquery hasil buildPrefixQuery.
+// Task yang dibagikan ke user ikut dicari seperti pada daftar task.
+const (
+	SearchTask = `SELECT id, title, description, priority, status, expires_at,
+			ts_rank(search_vector, query) AS rank, ` + PermissionColumn + `
+		FROM public.tasks, to_tsquery('simple', $2) query
+		WHERE ` + TaskAccessFilter + ` AND deleted_at IS NULL AND search_vector @@ query
+		ORDER BY rank DESC, id DESC
+		LIMIT $3 OFFSET $4;`
+
+	CountSearchTask = `SELECT COUNT(*) FROM public.tasks
+		WHERE ` + TaskAccessFilter + ` AND deleted_at IS NULL AND search_vector @@ to_tsquery('simple', $2);`
+)
+
+// SearchTask mencari task yang bisa diakses user dengan prefix matching pada title dan description,
+// diurutkan berdasarkan relevansi
+func (repo *taskRepo) SearchTask(req *dto.SearchTaskReqDTO) ([]*dto.SearchTaskRespDTO, int64, error) {
+	query := buildPrefixQuery(req.Query)
+	resp := []*dto.SearchTaskRespDTO{}
+
+	// Kata kunci tanpa huruf atau angka tidak akan cocok dengan task apa pun
+	if query == "" {
+		return resp, 0, nil
+	}
+
+	var total int64
+	err := statement.countSearchTask.Get(&total, req.UserID, query)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	err = statement.searchTask.Select(&resp, req.UserID, query, req.PerPage, (req.Page-1)*req.PerPage)
+	if err != nil {
+		log.Println(err)
+		return nil, 0, err
+	}
+
+	// Snippet disusun di sini, bukan dengan ts_headline, agar title dan description
+	// di-escape sebagai HTML sebelum kata kunci ditandai
+	terms := helper.SearchTerms(req.Query)
+	for _, task := range resp {
+		task.Snippet = helper.Highlight(task.Title, terms)
+		task.DescriptionSnippet = helper.HighlightExcerpt(task.Description, terms, dto.SnippetWords)
+	}
+
+	return resp, total, nil
+}
+
+// buildPrefixQuery mengubah input user menjadi tsquery dengan prefix matching,
+// misalnya "rapat kan" menjadi "rapat:* & kan:*". Karakter selain huruf dan angka
+// dibuang agar input tidak bisa merusak sintaks tsquery.
+func buildPrefixQuery(input string) string {
+	words := helper.SearchTerms(input)
+
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+
+	return strings.Join(words, " & ")
+}
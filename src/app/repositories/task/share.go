@@ -0,0 +1,125 @@
+package task
+
+import (
+	"database/sql"
+	"log"
+	dto "todo_list/src/app/dto/share"
+	projectRepo "todo_list/src/app/repositories/project"
+)
+
+// ErrUserNotFound dikembalikan ketika tidak ada user lain dengan email yang diundang,
+// nilainya sama dengan sentinel repository project agar errors.Is berlaku di keduanya
+var ErrUserNotFound = projectRepo.ErrUserNotFound
+
+// ErrShareNotFound dikembalikan ketika task tidak dibagikan ke user yang dimaksud
+var ErrShareNotFound = projectRepo.ErrShareNotFound
+
+// Query SQL untuk berbagi task dengan user lain
+const (
+	// TaskAccessFilter membatasi task yang dimiliki user $1 atau dibagikan ke user $1,
+	// baik langsung maupun melalui project
+	TaskAccessFilter = `(tasks.user_id = $1
+		OR EXISTS (SELECT 1 FROM public.task_shares s WHERE s.task_id = tasks.id AND s.user_id = $1)
+		OR EXISTS (SELECT 1 FROM public.project_shares s WHERE s.project_id = tasks.project_id AND s.user_id = $1))`
+
+	// PermissionColumn menghitung hak akses user $1 terhadap task, editor lebih diutamakan dari viewer
+	PermissionColumn = `CASE
+			WHEN tasks.user_id = $1 THEN 'owner'
+			WHEN EXISTS (SELECT 1 FROM public.task_shares s
+				WHERE s.task_id = tasks.id AND s.user_id = $1 AND s.permission = 'editor')
+			OR EXISTS (SELECT 1 FROM public.project_shares s
+				WHERE s.project_id = tasks.project_id AND s.user_id = $1 AND s.permission = 'editor') THEN 'editor'
+			ELSE 'viewer'
+		END AS permission`
+
+	// ShareTask mengundang user berdasarkan email, undangan ulang memperbarui permission
+	ShareTask = `WITH shared AS (
+			INSERT INTO public.task_shares (task_id, user_id, permission)
+			SELECT t.id, u.id, $4
+			FROM public.tasks t JOIN public.users u ON LOWER(u.email) = LOWER($3) AND u.id <> t.user_id
+			WHERE t.id = $1 AND t.user_id = $2 AND t.deleted_at IS NULL
+			ON CONFLICT (task_id, user_id) DO UPDATE SET permission = EXCLUDED.permission
+			RETURNING user_id, permission, created_at
+		)
+		SELECT s.user_id, u.name, u.email, s.permission, s.created_at
+		FROM shared s JOIN public.users u ON u.id = s.user_id;`
+
+	GetTaskShares = `SELECT s.user_id, u.name, u.email, s.permission, s.created_at
+		FROM public.task_shares s
+		JOIN public.users u ON u.id = s.user_id
+		JOIN public.tasks t ON t.id = s.task_id
+		WHERE s.task_id = $1 AND t.user_id = $2
+		ORDER BY s.created_at, s.user_id;`
+
+	// RemoveTaskShare bisa dilakukan pemilik task atau kolaborator itu sendiri
+	RemoveTaskShare = `DELETE FROM public.task_shares s
+		USING public.tasks t
+		WHERE t.id = s.task_id AND s.task_id = $1 AND s.user_id = $3 AND (t.user_id = $2 OR s.user_id = $2);`
+
+	ProjectVisible = `SELECT EXISTS (
+			SELECT 1 FROM public.projects p
+			WHERE p.id = $1 AND (p.user_id = $2
+				OR EXISTS (SELECT 1 FROM public.project_shares s WHERE s.project_id = p.id AND s.user_id = $2))
+		);`
+)
+
+// ShareTask membagikan task milik user ke user lain
+func (repo *taskRepo) ShareTask(req *dto.ShareReqDTO) (*dto.ShareRespDTO, error) {
+	var resp dto.ShareRespDTO
+	err := statement.shareTask.Get(&resp, req.ID, req.UserID, req.Email, req.Permission)
+	if err == sql.ErrNoRows {
+		return nil, ErrUserNotFound
+	}
+	if err != nil {
+		log.Println("Failed to share task:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetTaskShares mengambil daftar kolaborator task milik user
+func (repo *taskRepo) GetTaskShares(req *dto.GetShareListReqDTO) ([]*dto.ShareRespDTO, error) {
+	resp := []*dto.ShareRespDTO{}
+	err := statement.getTaskShares.Select(&resp, req.ID, req.UserID)
+	if err != nil {
+		log.Println("Failed to get task shares:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// RemoveTaskShare mencabut akses kolaborator dari task
+func (repo *taskRepo) RemoveTaskShare(req *dto.RemoveShareReqDTO) error {
+	result, err := statement.removeTaskShare.Exec(req.ID, req.UserID, req.ShareUserID)
+	if err != nil {
+		log.Println("Failed to delete task share:", err)
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrShareNotFound
+	}
+
+	return nil
+}
+
+// ProjectVisible memastikan project milik user atau dibagikan ke user
+func (repo *taskRepo) ProjectVisible(projectID int64, userID int64) error {
+	var visible bool
+	err := statement.projectVisible.Get(&visible, projectID, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if !visible {
+		return ErrProjectNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package task
+
+import (
+	"database/sql"
+	"log"
+	"slices"
+	dto "todo_list/src/app/dto/task"
+)
+
+// Query SQL untuk snooze task
+const (
+	// SnoozeTask mengundur expires_at dan mengembalikan task expired menjadi pending
+	SnoozeTask = `UPDATE public.tasks SET
+			expires_at = $2,
+			status = CASE WHEN status = 'expired' THEN 'pending' ELSE status END,
+			snooze_count = snooze_count + 1,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING ` + TaskColumns + `;`
+)
+
+// SnoozeTask mengundur expires_at task milik user jika statusnya termasuk req.From,
+// menjadwalkan ulang pengingat, dan mencatatnya di riwayat task.
+// Mengembalikan task terbaru beserta status sebelum snooze.
+func (repo *taskRepo) SnoozeTask(req *dto.SnoozeTaskReqDTO) (resp *dto.GetTaskRespDTO, from string, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return nil, "", err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	// Status dikunci agar snooze tidak bertabrakan dengan expire atau perpindahan status lain
+	err = tx.Stmtx(statement.lockTaskStatus).Get(&from, req.ID, req.UserID)
+	if err == sql.ErrNoRows {
+		err = ErrTaskNotFound
+		return nil, "", err
+	}
+	if err != nil {
+		log.Println("Failed to lock task status:", err)
+		return nil, "", err
+	}
+
+	if !slices.Contains(req.From, from) {
+		err = ErrInvalidTransition
+		return nil, "", err
+	}
+
+	old, err := taskSnapshot(tx, req.ID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp = &dto.GetTaskRespDTO{}
+	err = tx.Stmtx(statement.snoozeTask).Get(resp, req.ID, req.ExpiresAt)
+	if err != nil {
+		log.Println("Failed to snooze task:", err)
+		return nil, "", err
+	}
+
+	// Pengingat mengikuti expires_at yang baru
+	if _, err = tx.Stmtx(statement.rescheduleReminder).Exec(req.ID); err != nil {
+		log.Println("Failed to reschedule reminders:", err)
+		return nil, "", err
+	}
+	if err = tx.Stmtx(statement.getTaskReminders).Get(&resp.Reminders, req.ID); err != nil {
+		log.Println("Failed to get task reminders:", err)
+		return nil, "", err
+	}
+
+	if err = attachTags(tx.Stmtx(statement.getTaskTags), resp); err != nil {
+		return nil, "", err
+	}
+
+	if err = addTaskEvent(tx, req.ID, dto.TaskEventSnooze, req.Audit, old); err != nil {
+		return nil, "", err
+	}
+
+	return resp, from, nil
+}
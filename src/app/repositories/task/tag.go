@@ -0,0 +1,87 @@
+package task
+
+import (
+	"log"
+	dto "todo_list/src/app/dto/task"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// Query SQL untuk tag yang terpasang pada task
+const (
+	// UpsertTags membuat tag milik user yang belum ada berdasarkan nama
+	UpsertTags = `INSERT INTO public.tags (user_id, name)
+		SELECT $1, unnest($2::text[])
+		ON CONFLICT (user_id, name) DO NOTHING;`
+
+	AssignTags = `INSERT INTO public.task_tags (task_id, tag_id)
+		SELECT $1, id FROM public.tags WHERE user_id = $2 AND name = ANY($3)
+		ON CONFLICT DO NOTHING;`
+
+	ClearTaskTags = `DELETE FROM public.task_tags WHERE task_id = $1;`
+
+	// GetTaskTags mengambil tag untuk beberapa task sekaligus
+	GetTaskTags = `SELECT tt.task_id, t.id, t.name, t.color
+		FROM public.task_tags tt JOIN public.tags t ON t.id = tt.tag_id
+		WHERE tt.task_id = ANY($1)
+		ORDER BY t.name;`
+)
+
+// assignTags memasang tag ke task di dalam transaksi, tag yang belum ada akan dibuat
+func assignTags(tx *sqlx.Tx, taskID int64, userID int64, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	if _, err := tx.Stmtx(statement.upsertTags).Exec(userID, pq.Array(tags)); err != nil {
+		log.Println("Failed to upsert tags:", err)
+		return err
+	}
+
+	if _, err := tx.Stmtx(statement.assignTags).Exec(taskID, userID, pq.Array(tags)); err != nil {
+		log.Println("Failed to assign tags:", err)
+		return err
+	}
+
+	return nil
+}
+
+// replaceTags mengganti seluruh tag task dengan daftar tag yang baru
+func replaceTags(tx *sqlx.Tx, taskID int64, userID int64, tags []string) error {
+	if _, err := tx.Stmtx(statement.clearTaskTags).Exec(taskID); err != nil {
+		log.Println("Failed to clear task tags:", err)
+		return err
+	}
+
+	return assignTags(tx, taskID, userID, tags)
+}
+
+// attachTags mengisi tag pada daftar task dengan satu query untuk semua task
+func attachTags(stmt *sqlx.Stmt, tasks ...*dto.GetTaskRespDTO) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(tasks))
+	byID := make(map[int64]*dto.GetTaskRespDTO, len(tasks))
+	for _, task := range tasks {
+		task.Tags = []*dto.TaskTagDTO{}
+		ids = append(ids, task.ID)
+		byID[task.ID] = task
+	}
+
+	var tags []*dto.TaskTagDTO
+	if err := stmt.Select(&tags, pq.Array(ids)); err != nil {
+		log.Println("Failed to get task tags:", err)
+		return err
+	}
+
+	for _, tag := range tags {
+		if task, ok := byID[tag.TaskID]; ok {
+			task.Tags = append(task.Tags, tag)
+		}
+	}
+
+	return nil
+}
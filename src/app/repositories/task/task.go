@@ -1,28 +1,288 @@
 package task
 
 import (
+	"database/sql"
+	"errors"
 	"log"
+	"slices"
+	"time"
+	shareDto "todo_list/src/app/dto/share"
 	dto "todo_list/src/app/dto/task"
+	projectRepo "todo_list/src/app/repositories/project"
+	"todo_list/src/infra/helper"
 
 	"github.com/jmoiron/sqlx"
 )
 
+// ErrTaskNotFound dikembalikan ketika task tidak ada atau bukan milik user
+var ErrTaskNotFound = errors.New("task not found")
+
+// ErrItemNotFound dikembalikan ketika item checklist tidak ada di task
+var ErrItemNotFound = errors.New("checklist item not found")
+
+// ErrRecurrenceNotFound dikembalikan ketika task bukan bagian dari seri berulang milik user
+var ErrRecurrenceNotFound = errors.New("recurrence not found")
+
+// ErrDependencyCycle dikembalikan ketika ketergantungan baru membentuk siklus
+var ErrDependencyCycle = errors.New("dependency cycle")
+
+// ErrDependencyNotFound dikembalikan ketika task tidak bergantung pada task yang dimaksud
+var ErrDependencyNotFound = errors.New("dependency not found")
+
+// ErrInvalidTransition dikembalikan ketika status task saat ini tidak mengizinkan perpindahan status
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+// ErrTaskBlocked dikembalikan ketika task diselesaikan selagi masih menunggu task lain yang belum selesai
+var ErrTaskBlocked = errors.New("task is blocked by pending tasks")
+
+// ErrProjectNotFound dikembalikan ketika project tujuan tidak ada atau bukan milik user,
+// nilainya sama dengan sentinel repository project
+var ErrProjectNotFound = projectRepo.ErrProjectNotFound
+
 // TaskRepository mendefinisikan metode yang harus diimplementasikan
 
 type TaskRepository interface {
-	GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error)
+	GetTask(req *dto.GetTaskByIDReqDTO) (*dto.GetTaskRespDTO, error)
+	AddTask(req *dto.CreateTaskReqDTO) (int64, error)
+	UpdateTask(req *dto.UpdateTaskReqDTO) (*dto.GetTaskRespDTO, error)
+	FinishTask(req *dto.FinishtTaskReqDTO) (*dto.TaskTransitionEventDTO, error)
+	TransitionTask(req *dto.TransitionTaskReqDTO) (*dto.TaskTransitionEventDTO, error)
+	ExpireTask(req *dto.ExpireTaskReqDTO) (*dto.TaskExpiredEventDTO, error)
+	ExpireOverdueTasks(limit int) ([]*dto.TaskExpiredEventDTO, error)
+	GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, int64, error)
+	SearchTask(req *dto.SearchTaskReqDTO) ([]*dto.SearchTaskRespDTO, int64, error)
+	DeleteTask(req *dto.DeleteTaskReqDTO) error
+	RestoreTask(req *dto.RestoreTaskReqDTO) (*dto.GetTaskRespDTO, error)
+	GetTrashList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error)
+	PurgeTrash(deletedBefore time.Time) ([]int64, error)
+	GetChecklist(req *dto.GetChecklistReqDTO) ([]*dto.ChecklistItemRespDTO, error)
+	AddChecklistItem(req *dto.AddChecklistItemReqDTO) (*dto.ChecklistItemRespDTO, error)
+	CheckChecklistItem(req *dto.CheckChecklistItemReqDTO) (*dto.ChecklistSummaryDTO, error)
+	ReorderChecklist(req *dto.ReorderChecklistReqDTO) error
+	DeleteChecklistItem(req *dto.DeleteChecklistItemReqDTO) error
+	ProjectExists(projectID int64, userID int64) error
+	GetTaskRecurrence(taskID int64) (*dto.TaskRecurrenceDTO, error)
+	AddNextInstance(req *dto.NextInstanceReqDTO) (int64, error)
+	GetRecurrence(req *dto.GetRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error)
+	UpdateRecurrence(req *dto.UpdateRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error)
+	StopRecurrence(req *dto.StopRecurrenceReqDTO) error
+	ClaimDueReminders(limit int) ([]*dto.TaskReminderEventDTO, error)
+	MarkReminderSent(id int64) error
+	ReleaseReminder(id int64) error
+	AddDependency(req *dto.AddDependencyReqDTO) error
+	RemoveDependency(req *dto.RemoveDependencyReqDTO) error
+	ShareTask(req *shareDto.ShareReqDTO) (*shareDto.ShareRespDTO, error)
+	GetTaskShares(req *shareDto.GetShareListReqDTO) ([]*shareDto.ShareRespDTO, error)
+	RemoveTaskShare(req *shareDto.RemoveShareReqDTO) error
+	ProjectVisible(projectID int64, userID int64) error
+	AddComment(req *dto.AddCommentReqDTO) (*dto.CommentRespDTO, error)
+	GetCommentList(req *dto.GetCommentListReqDTO) ([]*dto.CommentRespDTO, int64, error)
+	UpdateComment(req *dto.UpdateCommentReqDTO) (*dto.CommentRespDTO, error)
+	DeleteComment(req *dto.DeleteCommentReqDTO) error
+	GetAttachmentUsage(userID int64) (int64, error)
+	AddAttachment(req *dto.AddAttachmentReqDTO, quota int64) (*dto.AttachmentRespDTO, error)
+	GetAttachmentList(req *dto.GetAttachmentListReqDTO) ([]*dto.AttachmentRespDTO, error)
+	GetAttachment(req *dto.GetAttachmentReqDTO) (*dto.AttachmentRespDTO, error)
+	DeleteAttachment(req *dto.DeleteAttachmentReqDTO) (*dto.AttachmentRespDTO, error)
+	GetTaskHistory(req *dto.GetTaskHistoryReqDTO) ([]*dto.TaskEventRespDTO, int64, error)
+	BulkTask(req *dto.BulkTaskReqDTO) ([]*dto.BulkTaskResultDTO, error)
+	StartTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error)
+	StopTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error)
+	GetTimeReport(req *dto.GetTimeReportReqDTO) ([]*dto.TimeReportRowDTO, error)
+	SnoozeTask(req *dto.SnoozeTaskReqDTO) (*dto.GetTaskRespDTO, string, error)
+	MoveTask(req *dto.MoveTaskReqDTO) (*dto.GetTaskRespDTO, error)
 }
 
 // Query SQL untuk berbagai operasi database
 const (
-	GetTaskList = `SELECT id, title, status, expires_at from public.tasks where user_id = $1`
+	// ProgressColumn menghitung persentase checklist yang sudah selesai
+	ProgressColumn = `(SELECT ROUND(100.0 * COUNT(*) FILTER (WHERE i.is_done) / NULLIF(COUNT(*), 0))::int
+		FROM public.task_checklist_items i WHERE i.task_id = tasks.id) AS progress`
+
+	// RemindersColumn mengambil offset pengingat task dalam detik
+	RemindersColumn = `(SELECT COALESCE(ARRAY_AGG(r.offset_seconds ORDER BY r.offset_seconds), '{}')
+		FROM public.task_reminders r WHERE r.task_id = tasks.id) AS reminders`
+
+	// BlockedByColumn mengambil id task yang belum selesai dan menghalangi task selesai
+	BlockedByColumn = `(SELECT COALESCE(ARRAY_AGG(d.depends_on_id ORDER BY d.depends_on_id), '{}')
+		FROM public.task_dependencies d JOIN public.tasks b ON b.id = d.depends_on_id
+		WHERE d.task_id = tasks.id AND b.status IN ('pending', 'in_progress') AND b.deleted_at IS NULL) AS blocked_by`
+
+	// OwnerColumns mengambil pemilik task
+	OwnerColumns = `tasks.user_id AS "owner.id",
+		(SELECT u.name FROM public.users u WHERE u.id = tasks.user_id) AS "owner.name",
+		(SELECT u.email FROM public.users u WHERE u.id = tasks.user_id) AS "owner.email"`
+
+	// TaskColumns adalah kolom task yang dikembalikan ke client
+	TaskColumns = `id, title, description, priority, notes, auto_finish, project_id, recurrence_id, status,
+		created_at, updated_at, expires_at, snooze_count, position, ` + ProgressColumn + `, ` + RemindersColumn + `,
+		` + BlockedByColumn + `, ` + TrackedColumn + `, ` + OwnerColumns
+
+	// Project yang bukan milik user diabaikan sehingga task tetap tersimpan tanpa project
+	AddTask = `INSERT INTO public.tasks (user_id, title, description, priority, notes, auto_finish, project_id, expires_at, recurrence_id)
+		VALUES ($1, $2, $3, COALESCE(NULLIF($4, ''), 'medium'), $5, $6,
+			(SELECT p.id FROM public.projects p WHERE p.id = $7 AND p.user_id = $1), $8, $9)
+		RETURNING id;`
+
+	// GetTask mengambil task milik user atau yang dibagikan ke user ($1)
+	GetTask = `SELECT ` + TaskColumns + `, ` + PermissionColumn + ` FROM public.tasks
+		WHERE id = $2 AND deleted_at IS NULL AND ` + TaskAccessFilter + `;`
+
+	UpdateTask = `UPDATE public.tasks SET
+			title = COALESCE($3, title),
+			description = COALESCE($4, description),
+			priority = COALESCE($5, priority),
+			notes = COALESCE($6, notes),
+			auto_finish = COALESCE($7, auto_finish),
+			expires_at = COALESCE($8, expires_at),
+			project_id = CASE
+				WHEN $9::int IS NULL THEN project_id
+				WHEN $9 = 0 THEN NULL
+				ELSE (SELECT p.id FROM public.projects p WHERE p.id = $9 AND p.user_id = $2)
+			END,
+			updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+		RETURNING ` + TaskColumns + `;`
+
+	// LockTaskStatus mengunci task milik user dan mengambil status saat ini sebelum dipindahkan
+	LockTaskStatus = `SELECT status FROM public.tasks
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL
+		FOR UPDATE;`
+
+	// TransitionTask mengganti expires_at hanya jika $3 diisi, contoh saat reopen task yang sudah lewat waktu
+	TransitionTask = `UPDATE public.tasks SET status = $2, expires_at = COALESCE($3, expires_at), updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1
+		RETURNING id, user_id, title, status, updated_at;`
+
+	// ExpireTask dan ExpireOverdueTasks mencatat riwayat expire di statement yang sama.
+	// Status yang bisa expire mengikuti state machine di use case task. ExpireTask juga
+	// memeriksa expires_at agar event TTL yang terlambat atau basi tidak meng-expire task
+	// yang expires_at-nya sudah diperpanjang.
+	ExpireTask = `WITH expired AS (
+			UPDATE public.tasks t SET status = 'expired', updated_at = CURRENT_TIMESTAMP
+			FROM (
+				SELECT id, status FROM public.tasks
+				WHERE id = $1 AND status IN ('pending', 'in_progress') AND expires_at <= CURRENT_TIMESTAMP
+					AND deleted_at IS NULL
+				FOR UPDATE
+			) old
+			WHERE t.id = old.id
+			RETURNING t.id, t.user_id, t.title, t.expires_at, old.status AS previous_status, t.updated_at
+		), ` + ExpiredEvents + `
+		SELECT id, user_id, title, expires_at, previous_status, updated_at FROM expired;`
+
+	ExpireOverdueTasks = `WITH expired AS (
+			UPDATE public.tasks t SET status = 'expired', updated_at = CURRENT_TIMESTAMP
+			FROM (
+				SELECT id, status FROM public.tasks
+				WHERE status IN ('pending', 'in_progress') AND expires_at < CURRENT_TIMESTAMP AND deleted_at IS NULL
+				ORDER BY expires_at
+				LIMIT $1
+				FOR UPDATE SKIP LOCKED
+			) old
+			WHERE t.id = old.id
+			RETURNING t.id, t.user_id, t.title, t.expires_at, old.status AS previous_status, t.updated_at
+		), ` + ExpiredEvents + `
+		SELECT id, user_id, title, expires_at, previous_status, updated_at FROM expired;`
+
+	DeleteTask = `UPDATE public.tasks SET deleted_at = CURRENT_TIMESTAMP, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL;`
+
+	RestoreTask = `UPDATE public.tasks SET deleted_at = NULL, updated_at = CURRENT_TIMESTAMP
+		WHERE id = $1 AND user_id = $2 AND deleted_at IS NOT NULL
+		RETURNING ` + TaskColumns + `;`
+
+	GetTrashList = `SELECT ` + TaskColumns + `, deleted_at FROM public.tasks
+		WHERE user_id = $1 AND deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC;`
+
+	PurgeTrash = `DELETE FROM public.tasks WHERE deleted_at < $1 RETURNING id;`
+
+	ProjectExists = `SELECT EXISTS (SELECT 1 FROM public.projects WHERE id = $1 AND user_id = $2);`
 )
 
 // Struct untuk menyimpan statement yang telah diprepare
 var statement PreparedStatement
 
 type PreparedStatement struct {
-	getTaskList *sqlx.Stmt
+	getTask             *sqlx.Stmt
+	addTask             *sqlx.Stmt
+	updateTask          *sqlx.Stmt
+	lockTaskStatus      *sqlx.Stmt
+	taskBlocked         *sqlx.Stmt
+	transitionTask      *sqlx.Stmt
+	expireTask          *sqlx.Stmt
+	expireOverdueTasks  *sqlx.Stmt
+	deleteTask          *sqlx.Stmt
+	restoreTask         *sqlx.Stmt
+	getTrashList        *sqlx.Stmt
+	purgeTrash          *sqlx.Stmt
+	searchTask          *sqlx.Stmt
+	countSearchTask     *sqlx.Stmt
+	getChecklist        *sqlx.Stmt
+	addChecklistItem    *sqlx.Stmt
+	checkItem           *sqlx.Stmt
+	checklistSummary    *sqlx.Stmt
+	lockChecklist       *sqlx.Stmt
+	deleteItem          *sqlx.Stmt
+	upsertTags          *sqlx.Stmt
+	assignTags          *sqlx.Stmt
+	clearTaskTags       *sqlx.Stmt
+	getTaskTags         *sqlx.Stmt
+	projectExists       *sqlx.Stmt
+	addRecurrence       *sqlx.Stmt
+	setLastTask         *sqlx.Stmt
+	getTaskRecurrence   *sqlx.Stmt
+	addNextInstance     *sqlx.Stmt
+	copyTaskTags        *sqlx.Stmt
+	getRecurrence       *sqlx.Stmt
+	updateRecurrence    *sqlx.Stmt
+	stopRecurrence      *sqlx.Stmt
+	addReminders        *sqlx.Stmt
+	keepReminders       *sqlx.Stmt
+	rescheduleReminder  *sqlx.Stmt
+	getTaskReminders    *sqlx.Stmt
+	copyTaskReminders   *sqlx.Stmt
+	claimDueReminders   *sqlx.Stmt
+	markReminderSent    *sqlx.Stmt
+	releaseReminder     *sqlx.Stmt
+	lockDependencies    *sqlx.Stmt
+	countOwnedTasks     *sqlx.Stmt
+	dependencyPath      *sqlx.Stmt
+	addDependency       *sqlx.Stmt
+	removeDependency    *sqlx.Stmt
+	shareTask           *sqlx.Stmt
+	getTaskShares       *sqlx.Stmt
+	removeTaskShare     *sqlx.Stmt
+	projectVisible      *sqlx.Stmt
+	addComment          *sqlx.Stmt
+	getCommentList      *sqlx.Stmt
+	countCommentList    *sqlx.Stmt
+	updateComment       *sqlx.Stmt
+	deleteComment       *sqlx.Stmt
+	lockAttachmentQuota *sqlx.Stmt
+	getAttachmentUsage  *sqlx.Stmt
+	addAttachment       *sqlx.Stmt
+	getAttachmentList   *sqlx.Stmt
+	getAttachment       *sqlx.Stmt
+	deleteAttachment    *sqlx.Stmt
+	taskSnapshot        *sqlx.Stmt
+	addTaskEvent        *sqlx.Stmt
+	getTaskHistory      *sqlx.Stmt
+	countTaskHistory    *sqlx.Stmt
+	startTimer          *sqlx.Stmt
+	stopTimer           *sqlx.Stmt
+	getTimeReport       *sqlx.Stmt
+	snoozeTask          *sqlx.Stmt
+	lockTaskPositions   *sqlx.Stmt
+	maxTaskPosition     *sqlx.Stmt
+	unpositionedTasks   *sqlx.Stmt
+	orderedTasks        *sqlx.Stmt
+	setTaskPositions    *sqlx.Stmt
+	getTaskPosition     *sqlx.Stmt
+	nextTaskPosition    *sqlx.Stmt
+	prevTaskPosition    *sqlx.Stmt
+	moveTask            *sqlx.Stmt
 }
 
 type taskRepo struct {
@@ -51,18 +311,513 @@ func (p *taskRepo) Preparex(query string) *sqlx.Stmt {
 // InitPreparedStatement menginisialisasi prepared statement untuk query tertentu
 func InitPreparedStatement(m *taskRepo) {
 	statement = PreparedStatement{
-		getTaskList: m.Preparex(GetTaskList),
+		getTask:             m.Preparex(GetTask),
+		addTask:             m.Preparex(AddTask),
+		updateTask:          m.Preparex(UpdateTask),
+		lockTaskStatus:      m.Preparex(LockTaskStatus),
+		taskBlocked:         m.Preparex(TaskBlocked),
+		transitionTask:      m.Preparex(TransitionTask),
+		expireTask:          m.Preparex(ExpireTask),
+		expireOverdueTasks:  m.Preparex(ExpireOverdueTasks),
+		deleteTask:          m.Preparex(DeleteTask),
+		restoreTask:         m.Preparex(RestoreTask),
+		getTrashList:        m.Preparex(GetTrashList),
+		purgeTrash:          m.Preparex(PurgeTrash),
+		searchTask:          m.Preparex(SearchTask),
+		countSearchTask:     m.Preparex(CountSearchTask),
+		getChecklist:        m.Preparex(GetChecklist),
+		addChecklistItem:    m.Preparex(AddChecklistItem),
+		checkItem:           m.Preparex(CheckChecklistItem),
+		checklistSummary:    m.Preparex(ChecklistSummary),
+		lockChecklist:       m.Preparex(LockChecklist),
+		deleteItem:          m.Preparex(DeleteChecklistItem),
+		upsertTags:          m.Preparex(UpsertTags),
+		assignTags:          m.Preparex(AssignTags),
+		clearTaskTags:       m.Preparex(ClearTaskTags),
+		getTaskTags:         m.Preparex(GetTaskTags),
+		projectExists:       m.Preparex(ProjectExists),
+		addRecurrence:       m.Preparex(AddRecurrence),
+		setLastTask:         m.Preparex(SetLastTask),
+		getTaskRecurrence:   m.Preparex(GetTaskRecurrence),
+		addNextInstance:     m.Preparex(AddNextInstance),
+		copyTaskTags:        m.Preparex(CopyTaskTags),
+		getRecurrence:       m.Preparex(GetRecurrence),
+		updateRecurrence:    m.Preparex(UpdateRecurrence),
+		stopRecurrence:      m.Preparex(StopRecurrence),
+		addReminders:        m.Preparex(AddReminders),
+		keepReminders:       m.Preparex(KeepReminders),
+		rescheduleReminder:  m.Preparex(RescheduleReminders),
+		getTaskReminders:    m.Preparex(GetTaskReminders),
+		copyTaskReminders:   m.Preparex(CopyTaskReminders),
+		claimDueReminders:   m.Preparex(ClaimDueReminders),
+		markReminderSent:    m.Preparex(MarkReminderSent),
+		releaseReminder:     m.Preparex(ReleaseReminder),
+		lockDependencies:    m.Preparex(LockDependencies),
+		countOwnedTasks:     m.Preparex(CountOwnedTasks),
+		dependencyPath:      m.Preparex(DependencyPath),
+		addDependency:       m.Preparex(AddDependency),
+		removeDependency:    m.Preparex(RemoveDependency),
+		shareTask:           m.Preparex(ShareTask),
+		getTaskShares:       m.Preparex(GetTaskShares),
+		removeTaskShare:     m.Preparex(RemoveTaskShare),
+		projectVisible:      m.Preparex(ProjectVisible),
+		addComment:          m.Preparex(AddComment),
+		getCommentList:      m.Preparex(GetCommentList),
+		countCommentList:    m.Preparex(CountCommentList),
+		updateComment:       m.Preparex(UpdateComment),
+		deleteComment:       m.Preparex(DeleteComment),
+		lockAttachmentQuota: m.Preparex(LockAttachmentQuota),
+		getAttachmentUsage:  m.Preparex(GetAttachmentUsage),
+		addAttachment:       m.Preparex(AddAttachment),
+		getAttachmentList:   m.Preparex(GetAttachmentList),
+		getAttachment:       m.Preparex(GetAttachment),
+		deleteAttachment:    m.Preparex(DeleteAttachment),
+		taskSnapshot:        m.Preparex(TaskSnapshot),
+		addTaskEvent:        m.Preparex(AddTaskEvent),
+		getTaskHistory:      m.Preparex(GetTaskHistory),
+		countTaskHistory:    m.Preparex(CountTaskHistory),
+		startTimer:          m.Preparex(StartTimer),
+		stopTimer:           m.Preparex(StopTimer),
+		getTimeReport:       m.Preparex(GetTimeReport),
+		snoozeTask:          m.Preparex(SnoozeTask),
+		lockTaskPositions:   m.Preparex(LockTaskPositions),
+		maxTaskPosition:     m.Preparex(MaxTaskPosition),
+		unpositionedTasks:   m.Preparex(UnpositionedTasks),
+		orderedTasks:        m.Preparex(OrderedTasks),
+		setTaskPositions:    m.Preparex(SetTaskPositions),
+		getTaskPosition:     m.Preparex(GetTaskPosition),
+		nextTaskPosition:    m.Preparex(NextTaskPosition),
+		prevTaskPosition:    m.Preparex(PrevTaskPosition),
+		moveTask:            m.Preparex(MoveTask),
 	}
 }
 
-func (repo *taskRepo) GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error) {
-	var resp []*dto.GetTaskRespDTO
-	err := statement.getTaskList.Select(&resp, req.UserID)
+// AddTask menyimpan task baru beserta tag, pengingat, seri berulang, dan riwayatnya ke database
+// dan mengembalikan id task
+func (repo *taskRepo) AddTask(req *dto.CreateTaskReqDTO) (id int64, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return 0, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	// Task berulang disimpan bersama template seri-nya
+	var recurrenceID *int64
+	if req.Recurrence != "" {
+		recurrenceID, err = addRecurrence(tx, req)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err = tx.Stmtx(statement.addTask).QueryRowx(
+		req.UserID, req.Title, req.Description, req.Priority, req.Notes, req.AutoFinish, req.ProjectID, req.ExpiresAt,
+		recurrenceID).Scan(&id)
+	if err != nil {
+		log.Println("Failed to insert task:", err)
+		return 0, err
+	}
+
+	if recurrenceID != nil {
+		if _, err = tx.Stmtx(statement.setLastTask).Exec(*recurrenceID, id); err != nil {
+			log.Println("Failed to update recurrence:", err)
+			return 0, err
+		}
+	}
+
+	if err = assignTags(tx, id, req.UserID, req.Tags); err != nil {
+		return 0, err
+	}
+
+	if err = addReminders(tx, id, helper.ReminderSeconds(req.Reminders)); err != nil {
+		return 0, err
+	}
+
+	if err = addTaskEvent(tx, id, dto.TaskEventCreate, req.Audit, nil); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// GetTask mengambil satu task yang bisa diakses user beserta hak aksesnya
+func (repo *taskRepo) GetTask(req *dto.GetTaskByIDReqDTO) (*dto.GetTaskRespDTO, error) {
+	var resp dto.GetTaskRespDTO
+	err := statement.getTask.Get(&resp, req.UserID, req.ID)
+	if err == sql.ErrNoRows {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		log.Println("Failed to get task:", err)
+		return nil, err
+	}
+
+	if err := attachTags(statement.getTaskTags, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// UpdateTask memperbarui field task milik user beserta tag dan pengingatnya
+// dan mencatat perubahannya di riwayat task
+func (repo *taskRepo) UpdateTask(req *dto.UpdateTaskReqDTO) (resp *dto.GetTaskRespDTO, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return nil, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	return updateTask(tx, req)
+}
+
+// updateTask memperbarui task di dalam transaksi yang sudah berjalan
+func updateTask(tx *sqlx.Tx, req *dto.UpdateTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	old, err := taskSnapshot(tx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetTaskRespDTO{}
+	err = tx.Stmtx(statement.updateTask).Get(resp,
+		req.ID, req.UserID, req.Title, req.Description, req.Priority, req.Notes, req.AutoFinish, req.ExpiresAt, req.ProjectID)
+	if err == sql.ErrNoRows {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		log.Println("Failed to update task:", err)
+		return nil, err
+	}
+
+	if req.Tags != nil {
+		if err := replaceTags(tx, req.ID, req.UserID, *req.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	// Pengingat mengikuti expires_at yang baru
+	if req.ExpiresAt != nil {
+		if _, err := tx.Stmtx(statement.rescheduleReminder).Exec(req.ID); err != nil {
+			log.Println("Failed to reschedule reminders:", err)
+			return nil, err
+		}
+	}
+
+	if req.Reminders != nil {
+		if err := replaceReminders(tx, req.ID, helper.ReminderSeconds(*req.Reminders)); err != nil {
+			return nil, err
+		}
+		if err := tx.Stmtx(statement.getTaskReminders).Get(&resp.Reminders, req.ID); err != nil {
+			log.Println("Failed to get task reminders:", err)
+			return nil, err
+		}
+	}
+
+	if err := attachTags(tx.Stmtx(statement.getTaskTags), resp); err != nil {
+		return nil, err
+	}
+
+	if err := addTaskEvent(tx, req.ID, dto.TaskEventUpdate, req.Audit, old); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// FinishTask mengubah status task milik user menjadi done dan mencatatnya di riwayat task
+func (repo *taskRepo) FinishTask(req *dto.FinishtTaskReqDTO) (*dto.TaskTransitionEventDTO, error) {
+	return repo.TransitionTask(&dto.TransitionTaskReqDTO{
+		ID:     req.ID,
+		UserID: req.UserID,
+		Action: dto.TaskEventFinish,
+		From:   req.From,
+		To:     dto.StatusDone,
+		Audit:  req.Audit,
+	})
+}
+
+// TransitionTask memindahkan status task milik user jika status saat ini termasuk req.From
+// dan mencatatnya di riwayat task
+func (repo *taskRepo) TransitionTask(req *dto.TransitionTaskReqDTO) (resp *dto.TaskTransitionEventDTO, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return nil, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	return transitionTask(tx, req)
+}
+
+// transitionTask memindahkan status task di dalam transaksi yang sudah berjalan
+func transitionTask(tx *sqlx.Tx, req *dto.TransitionTaskReqDTO) (*dto.TaskTransitionEventDTO, error) {
+	// Status dikunci agar perpindahan yang berjalan bersamaan tidak saling menimpa
+	var from string
+	err := tx.Stmtx(statement.lockTaskStatus).Get(&from, req.ID, req.UserID)
+	if err == sql.ErrNoRows {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		log.Println("Failed to lock task status:", err)
+		return nil, err
+	}
+
+	if !slices.Contains(req.From, from) {
+		return nil, ErrInvalidTransition
+	}
+
+	// Ketergantungan bisa bertambah atau task penghalang dibuka kembali setelah event finish dikirim,
+	// sehingga penghalang dicek ulang selagi task terkunci
+	if req.To == dto.StatusDone {
+		var blocked bool
+		if err := tx.Stmtx(statement.taskBlocked).Get(&blocked, req.ID); err != nil {
+			log.Println("Failed to check task blockers:", err)
+			return nil, err
+		}
+		if blocked {
+			return nil, ErrTaskBlocked
+		}
+	}
+
+	old, err := taskSnapshot(tx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.TaskTransitionEventDTO{}
+	err = tx.Stmtx(statement.transitionTask).Get(resp, req.ID, req.To, req.ExpiresAt)
+	if err != nil {
+		log.Println("Failed to update task status:", err)
+		return nil, err
+	}
+
+	// Pengingat mengikuti expires_at yang baru
+	if req.ExpiresAt != nil {
+		if _, err := tx.Stmtx(statement.rescheduleReminder).Exec(req.ID); err != nil {
+			log.Println("Failed to reschedule reminders:", err)
+			return nil, err
+		}
+	}
+	resp.Action = req.Action
+	resp.From = from
+	resp.ActorID = req.Audit.ActorID
+	resp.RequestID = req.Audit.RequestID
+
+	if err := addTaskEvent(tx, req.ID, req.Action, req.Audit, old); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// ExpireTask mengubah status task yang masih pending menjadi expired.
+// Mengembalikan nil jika task sudah tidak berstatus pending atau expires_at-nya belum lewat.
+func (repo *taskRepo) ExpireTask(req *dto.ExpireTaskReqDTO) (*dto.TaskExpiredEventDTO, error) {
+	var resp dto.TaskExpiredEventDTO
+	err := statement.expireTask.Get(&resp, req.ID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		log.Println("Failed to expire task:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// ExpireOverdueTasks mengubah status task pending yang sudah lewat expires_at
+// menjadi expired, maksimal sebanyak limit task per pemanggilan
+func (repo *taskRepo) ExpireOverdueTasks(limit int) ([]*dto.TaskExpiredEventDTO, error) {
+	var resp []*dto.TaskExpiredEventDTO
+	err := statement.expireOverdueTasks.Select(&resp, limit)
+	if err != nil {
+		log.Println("Failed to expire overdue tasks:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// DeleteTask memindahkan task milik user ke trash (soft delete) dan mencatatnya di riwayat task
+func (repo *taskRepo) DeleteTask(req *dto.DeleteTaskReqDTO) (err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	return deleteTask(tx, req)
+}
+
+// deleteTask memindahkan task ke trash di dalam transaksi yang sudah berjalan
+func deleteTask(tx *sqlx.Tx, req *dto.DeleteTaskReqDTO) error {
+	old, err := taskSnapshot(tx, req.ID)
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.Stmtx(statement.deleteTask).Exec(req.ID, req.UserID)
+	if err != nil {
+		log.Println("Failed to delete task:", err)
+		return err
+	}
 
+	// Tidak ada baris yang berubah berarti task tidak ada, sudah dihapus, atau bukan milik user
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrTaskNotFound
+	}
+
+	return addTaskEvent(tx, req.ID, dto.TaskEventDelete, req.Audit, old)
+}
+
+// RestoreTask mengembalikan task milik user dari trash dan mencatatnya di riwayat task
+func (repo *taskRepo) RestoreTask(req *dto.RestoreTaskReqDTO) (resp *dto.GetTaskRespDTO, err error) {
+	// Mulai transaksi database
+	tx, err := repo.Connection.Beginx()
+	if err != nil {
+		log.Println("Failed to start transaction:", err)
+		return nil, err
+	}
+
+	// Pastikan transaksi rollback jika terjadi error
+	defer func() {
+		if r := recover(); r != nil {
+			tx.Rollback()
+			panic(r)
+		} else if err != nil {
+			tx.Rollback()
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	return restoreTask(tx, req)
+}
+
+// restoreTask mengembalikan task dari trash di dalam transaksi yang sudah berjalan
+func restoreTask(tx *sqlx.Tx, req *dto.RestoreTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	old, err := taskSnapshot(tx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.GetTaskRespDTO{}
+	err = tx.Stmtx(statement.restoreTask).Get(resp, req.ID, req.UserID)
+	if err == sql.ErrNoRows {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		log.Println("Failed to restore task:", err)
+		return nil, err
+	}
+
+	if err := attachTags(tx.Stmtx(statement.getTaskTags), resp); err != nil {
+		return nil, err
+	}
+
+	if err := addTaskEvent(tx, req.ID, dto.TaskEventRestore, req.Audit, old); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetTrashList mengambil daftar task milik user yang ada di trash
+func (repo *taskRepo) GetTrashList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error) {
+	var resp []*dto.GetTaskRespDTO
+	err := statement.getTrashList.Select(&resp, req.UserID)
 	if err != nil {
 		log.Println(err)
 		return nil, err
 	}
 
+	if err := attachTags(statement.getTaskTags, resp...); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
+
+// PurgeTrash menghapus permanen task di trash yang dihapus sebelum deletedBefore
+// dan mengembalikan id task yang terhapus
+func (repo *taskRepo) PurgeTrash(deletedBefore time.Time) ([]int64, error) {
+	var ids []int64
+	err := statement.purgeTrash.Select(&ids, deletedBefore)
+	if err != nil {
+		log.Println("Failed to purge trash:", err)
+		return nil, err
+	}
+
+	return ids, nil
+}
+
+// ProjectExists memastikan project ada dan milik user
+func (repo *taskRepo) ProjectExists(projectID int64, userID int64) error {
+	var exists bool
+	err := statement.projectExists.Get(&exists, projectID, userID)
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	if !exists {
+		return ErrProjectNotFound
+	}
+
+	return nil
+}
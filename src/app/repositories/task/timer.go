@@ -0,0 +1,91 @@
+package task
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/task"
+)
+
+// ErrTimerRunning dikembalikan ketika user masih memiliki timer yang berjalan
+var ErrTimerRunning = errors.New("timer already running")
+
+// ErrTimerNotRunning dikembalikan ketika user tidak memiliki timer yang berjalan pada task
+var ErrTimerNotRunning = errors.New("timer not running")
+
+// Query SQL untuk pencatatan waktu task
+const (
+	// TrackedColumn menghitung total waktu tercatat pada task dalam detik, termasuk timer yang berjalan
+	TrackedColumn = `(SELECT COALESCE(SUM(EXTRACT(EPOCH FROM COALESCE(e.stopped_at, CURRENT_TIMESTAMP) - e.started_at)), 0)::bigint
+		FROM public.time_entries e WHERE e.task_id = tasks.id) AS tracked_seconds`
+
+	TimeEntryColumns = `id, task_id, user_id, started_at, stopped_at,
+		EXTRACT(EPOCH FROM COALESCE(stopped_at, CURRENT_TIMESTAMP) - started_at)::bigint AS duration_seconds, note`
+
+	// StartTimer tidak menyimpan apa pun jika user masih memiliki timer yang berjalan,
+	// dijaga oleh unique index idx_time_entries_running
+	StartTimer = `INSERT INTO public.time_entries (task_id, user_id, note)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) WHERE stopped_at IS NULL DO NOTHING
+		RETURNING ` + TimeEntryColumns + `;`
+
+	StopTimer = `UPDATE public.time_entries SET stopped_at = CURRENT_TIMESTAMP, note = COALESCE(NULLIF($3, ''), note)
+		WHERE task_id = $1 AND user_id = $2 AND stopped_at IS NULL
+		RETURNING ` + TimeEntryColumns + `;`
+
+	// GetTimeReport membagi setiap catatan waktu ke hari-hari yang dilewatinya
+	// sehingga timer yang melewati tengah malam dihitung di kedua hari
+	GetTimeReport = `SELECT d.day, e.task_id, t.title,
+			SUM(EXTRACT(EPOCH FROM LEAST(COALESCE(e.stopped_at, CURRENT_TIMESTAMP), d.day + INTERVAL '1 day')
+				- GREATEST(e.started_at, d.day)))::bigint AS seconds
+		FROM generate_series($2::date::timestamp, $3::date::timestamp, INTERVAL '1 day') AS d(day)
+		JOIN public.time_entries e ON e.user_id = $1
+			AND e.started_at < d.day + INTERVAL '1 day'
+			AND COALESCE(e.stopped_at, CURRENT_TIMESTAMP) > d.day
+		JOIN public.tasks t ON t.id = e.task_id
+		GROUP BY d.day, e.task_id, t.title
+		ORDER BY d.day, e.task_id;`
+)
+
+// StartTimer memulai timer user pada task.
+// Mengembalikan ErrTimerRunning jika user masih memiliki timer yang berjalan.
+func (repo *taskRepo) StartTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error) {
+	var resp dto.TimeEntryRespDTO
+	err := statement.startTimer.Get(&resp, req.TaskID, req.UserID, req.Note)
+	if err == sql.ErrNoRows {
+		return nil, ErrTimerRunning
+	}
+	if err != nil {
+		log.Println("Failed to start timer:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// StopTimer menghentikan timer user yang berjalan pada task
+func (repo *taskRepo) StopTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error) {
+	var resp dto.TimeEntryRespDTO
+	err := statement.stopTimer.Get(&resp, req.TaskID, req.UserID, req.Note)
+	if err == sql.ErrNoRows {
+		return nil, ErrTimerNotRunning
+	}
+	if err != nil {
+		log.Println("Failed to stop timer:", err)
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// GetTimeReport mengambil total waktu user per hari dan per task pada rentang tanggal
+func (repo *taskRepo) GetTimeReport(req *dto.GetTimeReportReqDTO) ([]*dto.TimeReportRowDTO, error) {
+	var resp []*dto.TimeReportRowDTO
+	err := statement.getTimeReport.Select(&resp, req.UserID, req.From.Format(dto.DateLayout), req.To.Format(dto.DateLayout))
+	if err != nil {
+		log.Println("Failed to get time report:", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
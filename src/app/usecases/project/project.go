@@ -0,0 +1,138 @@
+package project
+
+import (
+	"errors"
+	"log"
+	dto "todo_list/src/app/dto/project"           // Import DTO untuk Project
+	shareDto "todo_list/src/app/dto/share"        // Import DTO untuk berbagi project
+	repo "todo_list/src/app/repositories/project" // Import repository Project
+	common_error "todo_list/src/infra/errors"     // Import custom error
+)
+
+// ProjectUCInterface mendefinisikan contract untuk Project Use Case
+type ProjectUCInterface interface {
+	GetProjectList(req *dto.GetProjectListReqDTO) ([]*dto.ProjectRespDTO, error)
+	GetProject(req *dto.GetProjectReqDTO) (*dto.ProjectRespDTO, error)
+	AddProject(req *dto.CreateProjectReqDTO) (*dto.ProjectRespDTO, error)
+	UpdateProject(req *dto.UpdateProjectReqDTO) (*dto.ProjectRespDTO, error)
+	DeleteProject(req *dto.DeleteProjectReqDTO) error
+	ReorderProject(req *dto.ReorderProjectReqDTO) error
+	ShareProject(req *shareDto.ShareReqDTO) (*shareDto.ShareRespDTO, error)
+	GetProjectShares(req *shareDto.GetShareListReqDTO) ([]*shareDto.ShareRespDTO, error)
+	RemoveProjectShare(req *shareDto.RemoveShareReqDTO) error
+}
+
+// projectUseCase adalah implementasi dari ProjectUCInterface
+type projectUseCase struct {
+	Repo repo.ProjectRepository // Repository untuk mengakses database
+}
+
+// NewProjectUseCase membuat instance projectUseCase
+func NewProjectUseCase(r repo.ProjectRepository) ProjectUCInterface {
+	return &projectUseCase{
+		Repo: r,
+	}
+}
+
+// GetProjectList mengambil daftar project milik user beserta jumlah task per status
+func (uc *projectUseCase) GetProjectList(req *dto.GetProjectListReqDTO) ([]*dto.ProjectRespDTO, error) {
+	resp, err := uc.Repo.GetProjectList(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetProject mengambil satu project milik user
+func (uc *projectUseCase) GetProject(req *dto.GetProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	resp, err := uc.Repo.GetProject(req)
+	if err != nil {
+		return nil, projectError(err)
+	}
+	return resp, nil
+}
+
+// AddProject membuat project baru milik user
+func (uc *projectUseCase) AddProject(req *dto.CreateProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	resp, err := uc.Repo.AddProject(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateProject mengubah project milik user, termasuk mengarsipkan project
+func (uc *projectUseCase) UpdateProject(req *dto.UpdateProjectReqDTO) (*dto.ProjectRespDTO, error) {
+	resp, err := uc.Repo.UpdateProject(req)
+	if err != nil {
+		return nil, projectError(err)
+	}
+	return resp, nil
+}
+
+// DeleteProject menghapus project milik user
+func (uc *projectUseCase) DeleteProject(req *dto.DeleteProjectReqDTO) error {
+	if err := uc.Repo.DeleteProject(req); err != nil {
+		return projectError(err)
+	}
+	return nil
+}
+
+// ReorderProject mengubah urutan project milik user
+func (uc *projectUseCase) ReorderProject(req *dto.ReorderProjectReqDTO) error {
+	if err := uc.Repo.ReorderProject(req); err != nil {
+		return projectError(err)
+	}
+	return nil
+}
+
+// ShareProject membagikan project milik user ke user lain berdasarkan email.
+// Kolaborator mendapat akses ke semua task di dalam project.
+func (uc *projectUseCase) ShareProject(req *shareDto.ShareReqDTO) (*shareDto.ShareRespDTO, error) {
+	if _, err := uc.Repo.GetProject(&dto.GetProjectReqDTO{ID: req.ID, UserID: req.UserID}); err != nil {
+		return nil, projectError(err)
+	}
+
+	resp, err := uc.Repo.ShareProject(req)
+	if err != nil {
+		return nil, projectError(err)
+	}
+	return resp, nil
+}
+
+// GetProjectShares mengambil daftar kolaborator project milik user
+func (uc *projectUseCase) GetProjectShares(req *shareDto.GetShareListReqDTO) ([]*shareDto.ShareRespDTO, error) {
+	if _, err := uc.Repo.GetProject(&dto.GetProjectReqDTO{ID: req.ID, UserID: req.UserID}); err != nil {
+		return nil, projectError(err)
+	}
+
+	resp, err := uc.Repo.GetProjectShares(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RemoveProjectShare mencabut akses kolaborator, dilakukan oleh pemilik project
+// atau oleh kolaborator itu sendiri untuk keluar dari project
+func (uc *projectUseCase) RemoveProjectShare(req *shareDto.RemoveShareReqDTO) error {
+	if err := uc.Repo.RemoveProjectShare(req); err != nil {
+		return projectError(err)
+	}
+	return nil
+}
+
+// projectError mengubah error not found dari repository menjadi error yang dikenali client
+func projectError(err error) error {
+	if errors.Is(err, repo.ErrProjectNotFound) {
+		return common_error.NewError(common_error.PROJECT_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrUserNotFound) {
+		return common_error.NewError(common_error.USER_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrShareNotFound) {
+		return common_error.NewError(common_error.SHARE_NOT_FOUND, err)
+	}
+	log.Println(err)
+	return err
+}
@@ -0,0 +1,100 @@
+package project
+
+import (
+	"errors"
+	"testing"
+	mockRepo "todo_list/mock/repositories/project"
+	dto "todo_list/src/app/dto/project"
+	shareDto "todo_list/src/app/dto/share"
+	repo "todo_list/src/app/repositories/project"
+	common_error "todo_list/src/infra/errors"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ProjectUseCaseList struct {
+	suite.Suite
+
+	useCase  ProjectUCInterface
+	mockRepo *mockRepo.MockProject
+}
+
+func (suite *ProjectUseCaseList) SetupTest() {
+	suite.mockRepo = new(mockRepo.MockProject)
+	suite.useCase = NewProjectUseCase(suite.mockRepo)
+}
+
+func (u *ProjectUseCaseList) TestGetProjectListSuccess() {
+	req := &dto.GetProjectListReqDTO{UserID: 1}
+	resp := []*dto.ProjectRespDTO{{ID: 1, Name: "kantor", PendingCount: 3, DoneCount: 1}}
+	u.mockRepo.Mock.On("GetProjectList", req).Return(resp, nil)
+	data, err := u.useCase.GetProjectList(req)
+	u.Equal(nil, err)
+	u.Equal(resp, data)
+}
+
+func (u *ProjectUseCaseList) TestGetProjectOtherUser() {
+	req := &dto.GetProjectReqDTO{ID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetProject", req).Return(nil, repo.ErrProjectNotFound)
+	_, err := u.useCase.GetProject(req)
+	u.Equal(common_error.PROJECT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *ProjectUseCaseList) TestAddProjectFail() {
+	req := &dto.CreateProjectReqDTO{UserID: 1, Name: "kantor"}
+	u.mockRepo.Mock.On("AddProject", req).Return(nil, errors.New(mock.Anything))
+	_, err := u.useCase.AddProject(req)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *ProjectUseCaseList) TestUpdateProjectArchive() {
+	archived := true
+	req := &dto.UpdateProjectReqDTO{ID: 1, UserID: 1, Archived: &archived}
+	u.mockRepo.Mock.On("UpdateProject", req).Return(&dto.ProjectRespDTO{ID: 1, Archived: true}, nil)
+	data, err := u.useCase.UpdateProject(req)
+	u.Equal(nil, err)
+	u.True(data.Archived)
+}
+
+func (u *ProjectUseCaseList) TestDeleteProjectOtherUser() {
+	req := &dto.DeleteProjectReqDTO{ID: 1, UserID: 2}
+	u.mockRepo.Mock.On("DeleteProject", req).Return(repo.ErrProjectNotFound)
+	err := u.useCase.DeleteProject(req)
+	u.Equal(common_error.PROJECT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *ProjectUseCaseList) TestReorderProjectOtherUser() {
+	req := &dto.ReorderProjectReqDTO{UserID: 1, ProjectIDs: []int64{2, 1}}
+	u.mockRepo.Mock.On("ReorderProject", req).Return(repo.ErrProjectNotFound)
+	err := u.useCase.ReorderProject(req)
+	u.Equal(common_error.PROJECT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *ProjectUseCaseList) TestShareProjectSuccess() {
+	req := &shareDto.ShareReqDTO{ID: 1, UserID: 1, Email: "friend@mail.com", Permission: shareDto.PermissionViewer}
+	u.mockRepo.Mock.On("GetProject", &dto.GetProjectReqDTO{ID: 1, UserID: 1}).Return(&dto.ProjectRespDTO{ID: 1}, nil)
+	u.mockRepo.Mock.On("ShareProject", req).Return(&shareDto.ShareRespDTO{UserID: 2, Permission: shareDto.PermissionViewer}, nil)
+	resp, err := u.useCase.ShareProject(req)
+	u.Equal(nil, err)
+	u.Equal(int64(2), resp.UserID)
+}
+
+func (u *ProjectUseCaseList) TestShareProjectOtherUser() {
+	req := &shareDto.ShareReqDTO{ID: 1, UserID: 2, Email: "friend@mail.com", Permission: shareDto.PermissionViewer}
+	u.mockRepo.Mock.On("GetProject", &dto.GetProjectReqDTO{ID: 1, UserID: 2}).Return(nil, repo.ErrProjectNotFound)
+	_, err := u.useCase.ShareProject(req)
+	u.Equal(common_error.PROJECT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "ShareProject", mock.Anything)
+}
+
+func (u *ProjectUseCaseList) TestRemoveProjectShareNotFound() {
+	req := &shareDto.RemoveShareReqDTO{ID: 1, UserID: 1, ShareUserID: 3}
+	u.mockRepo.Mock.On("RemoveProjectShare", req).Return(repo.ErrShareNotFound)
+	err := u.useCase.RemoveProjectShare(req)
+	u.Equal(common_error.SHARE_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func TestUsecase(t *testing.T) {
+	suite.Run(t, new(ProjectUseCaseList))
+}
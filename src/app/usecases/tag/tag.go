@@ -0,0 +1,89 @@
+package tag
+
+import (
+	"errors"
+	"log"
+	"strings"
+	dto "todo_list/src/app/dto/tag"           // Import DTO untuk Tag
+	repo "todo_list/src/app/repositories/tag" // Import repository Tag
+	common_error "todo_list/src/infra/errors" // Import custom error
+)
+
+// TagUCInterface mendefinisikan contract untuk Tag Use Case
+type TagUCInterface interface {
+	GetTagList(req *dto.GetTagListReqDTO) ([]*dto.TagRespDTO, error)
+	AddTag(req *dto.CreateTagReqDTO) (*dto.TagRespDTO, error)
+	UpdateTag(req *dto.UpdateTagReqDTO) (*dto.TagRespDTO, error)
+	DeleteTag(req *dto.DeleteTagReqDTO) error
+}
+
+// tagUseCase adalah implementasi dari TagUCInterface
+type tagUseCase struct {
+	Repo repo.TagRepository // Repository untuk mengakses database
+}
+
+// NewTagUseCase membuat instance tagUseCase
+func NewTagUseCase(r repo.TagRepository) TagUCInterface {
+	return &tagUseCase{
+		Repo: r,
+	}
+}
+
+// GetTagList mengambil daftar tag milik user
+func (uc *tagUseCase) GetTagList(req *dto.GetTagListReqDTO) ([]*dto.TagRespDTO, error) {
+	resp, err := uc.Repo.GetTagList(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AddTag membuat tag baru milik user, nama tag disimpan dalam huruf kecil
+func (uc *tagUseCase) AddTag(req *dto.CreateTagReqDTO) (*dto.TagRespDTO, error) {
+	req.Name = normalizeName(req.Name)
+
+	resp, err := uc.Repo.AddTag(req)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return resp, nil
+}
+
+// UpdateTag mengubah nama dan/atau warna tag milik user
+func (uc *tagUseCase) UpdateTag(req *dto.UpdateTagReqDTO) (*dto.TagRespDTO, error) {
+	if req.Name != nil {
+		name := normalizeName(*req.Name)
+		req.Name = &name
+	}
+
+	resp, err := uc.Repo.UpdateTag(req)
+	if err != nil {
+		return nil, tagError(err)
+	}
+	return resp, nil
+}
+
+// DeleteTag menghapus tag milik user
+func (uc *tagUseCase) DeleteTag(req *dto.DeleteTagReqDTO) error {
+	if err := uc.Repo.DeleteTag(req); err != nil {
+		return tagError(err)
+	}
+	return nil
+}
+
+// normalizeName menyamakan format nama tag dengan tag yang dibuat dari task
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// tagError mengubah error dari repository menjadi error yang dikenali client
+func tagError(err error) error {
+	if errors.Is(err, repo.ErrTagNotFound) {
+		return common_error.NewError(common_error.TAG_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrTagAlreadyExist) {
+		return common_error.NewError(common_error.TAG_ALREADY_EXIST, err)
+	}
+	log.Println(err)
+	return err
+}
@@ -0,0 +1,68 @@
+package tag
+
+import (
+	"errors"
+	"testing"
+	mockRepo "todo_list/mock/repositories/tag"
+	dto "todo_list/src/app/dto/tag"
+	repo "todo_list/src/app/repositories/tag"
+	common_error "todo_list/src/infra/errors"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TagUseCaseList struct {
+	suite.Suite
+
+	useCase  TagUCInterface
+	mockRepo *mockRepo.MockTag
+}
+
+func (suite *TagUseCaseList) SetupTest() {
+	suite.mockRepo = new(mockRepo.MockTag)
+	suite.useCase = NewTagUseCase(suite.mockRepo)
+}
+
+func (u *TagUseCaseList) TestGetTagListSuccess() {
+	req := &dto.GetTagListReqDTO{UserID: 1}
+	resp := []*dto.TagRespDTO{{ID: 1, Name: "work", TaskCount: 2}}
+	u.mockRepo.Mock.On("GetTagList", req).Return(resp, nil)
+	data, err := u.useCase.GetTagList(req)
+	u.Equal(nil, err)
+	u.Equal(resp, data)
+}
+
+func (u *TagUseCaseList) TestAddTagNormalizesName() {
+	req := &dto.CreateTagReqDTO{UserID: 1, Name: "  Work "}
+	u.mockRepo.Mock.On("AddTag", &dto.CreateTagReqDTO{UserID: 1, Name: "work"}).Return(&dto.TagRespDTO{ID: 1, Name: "work"}, nil)
+	data, err := u.useCase.AddTag(req)
+	u.Equal(nil, err)
+	u.Equal("work", data.Name)
+}
+
+func (u *TagUseCaseList) TestAddTagAlreadyExist() {
+	req := &dto.CreateTagReqDTO{UserID: 1, Name: "work"}
+	u.mockRepo.Mock.On("AddTag", req).Return(nil, repo.ErrTagAlreadyExist)
+	_, err := u.useCase.AddTag(req)
+	u.Equal(common_error.TAG_ALREADY_EXIST, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *TagUseCaseList) TestUpdateTagOtherUser() {
+	color := "#ff8800"
+	req := &dto.UpdateTagReqDTO{ID: 1, UserID: 2, Color: &color}
+	u.mockRepo.Mock.On("UpdateTag", req).Return(nil, repo.ErrTagNotFound)
+	_, err := u.useCase.UpdateTag(req)
+	u.Equal(common_error.TAG_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *TagUseCaseList) TestDeleteTagFail() {
+	req := &dto.DeleteTagReqDTO{ID: 1, UserID: 1}
+	u.mockRepo.Mock.On("DeleteTag", req).Return(errors.New(mock.Anything))
+	err := u.useCase.DeleteTag(req)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func TestUsecase(t *testing.T) {
+	suite.Run(t, new(TagUseCaseList))
+}
@@ -0,0 +1,136 @@
+package task
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+	"todo_list/src/infra/persistence/blob"
+)
+
+// AddAttachment menyimpan file ke blob store lalu mencatat lampiran pada task yang bisa diubah user.
+// Ukuran file dibatasi ukuran maksimum per file dan sisa kuota pengunggah.
+func (uc *taskUseCase) AddAttachment(req *dto.AddAttachmentReqDTO) (*dto.AttachmentRespDTO, error) {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor); err != nil {
+		return nil, err
+	}
+
+	used, err := uc.Repo.GetAttachmentUsage(req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining := uc.Limits.UserQuota - used
+	if remaining <= 0 {
+		return nil, common_error.NewError(common_error.QUOTA_EXCEEDED, errors.New("storage quota is used up"))
+	}
+
+	// Baca paling banyak satu byte lebih dari batas untuk mengetahui file yang terlalu besar
+	limit := min(uc.Limits.MaxFileSize, remaining)
+	key, err := attachmentKey(req.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := uc.Blob.Put(key, io.LimitReader(req.File, limit+1))
+	if err != nil {
+		log.Println(err)
+		return nil, err
+	}
+
+	if size > limit {
+		uc.deleteBlob(key)
+		if limit == uc.Limits.MaxFileSize {
+			return nil, common_error.NewError(common_error.ATTACHMENT_TOO_LARGE, fmt.Errorf("file is larger than %d bytes", limit))
+		}
+		return nil, common_error.NewError(common_error.QUOTA_EXCEEDED, fmt.Errorf("only %d bytes of quota left", remaining))
+	}
+
+	req.StorageKey = key
+	req.Size = size
+	resp, err := uc.Repo.AddAttachment(req, uc.Limits.UserQuota)
+	if err != nil {
+		// Data lampiran tidak tersimpan, sehingga file di blob store tidak lagi dirujuk
+		uc.deleteBlob(key)
+		return nil, taskError(err)
+	}
+	return resp, nil
+}
+
+// GetAttachmentList mengambil daftar lampiran task yang bisa diakses user
+func (uc *taskUseCase) GetAttachmentList(req *dto.GetAttachmentListReqDTO) ([]*dto.AttachmentRespDTO, error) {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer); err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.Repo.GetAttachmentList(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetAttachment mengambil data lampiran beserta isi filenya untuk diunduh.
+// Pemanggil wajib menutup reader yang dikembalikan.
+func (uc *taskUseCase) GetAttachment(req *dto.GetAttachmentReqDTO) (*dto.AttachmentRespDTO, io.ReadCloser, error) {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := uc.Repo.GetAttachment(req)
+	if err != nil {
+		return nil, nil, taskError(err)
+	}
+
+	file, err := uc.Blob.Open(resp.StorageKey)
+	if errors.Is(err, blob.ErrBlobNotFound) {
+		return nil, nil, common_error.NewError(common_error.ATTACHMENT_NOT_FOUND, err)
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, nil, err
+	}
+	return resp, file, nil
+}
+
+// DeleteAttachment menghapus lampiran dari task yang bisa diubah user beserta filenya
+func (uc *taskUseCase) DeleteAttachment(req *dto.DeleteAttachmentReqDTO) error {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor); err != nil {
+		return err
+	}
+
+	resp, err := uc.Repo.DeleteAttachment(req)
+	if err != nil {
+		return taskError(err)
+	}
+
+	uc.deleteBlob(resp.StorageKey)
+	return nil
+}
+
+// deleteBlob menghapus file lampiran. Data lampiran sudah tidak ada,
+// sehingga kegagalan hapus hanya dicatat.
+func (uc *taskUseCase) deleteBlob(key string) {
+	if err := uc.Blob.Delete(key); err != nil {
+		log.Println(err)
+	}
+}
+
+// attachmentPrefix adalah prefix key semua lampiran milik satu task
+func attachmentPrefix(taskID int64) string {
+	return fmt.Sprintf("tasks/%d", taskID)
+}
+
+// attachmentKey membuat key acak untuk lampiran baru di bawah prefix task
+func attachmentKey(taskID int64) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return attachmentPrefix(taskID) + "/" + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,178 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"slices"
+	"time"
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	repo "todo_list/src/app/repositories/task"
+	Const "todo_list/src/infra/constants"
+	common_error "todo_list/src/infra/errors"
+)
+
+// BulkTask menjalankan banyak operasi task sekaligus dan mengembalikan hasil per operasi.
+// Hak akses setiap operasi dicek lebih dulu, pada mode atomic tidak ada yang dijalankan
+// jika ada operasi yang sudah pasti gagal. Operasi yang tersimpan dikirim sebagai satu event taskbulk,
+// ditambah event tasktransition untuk setiap task yang berpindah status.
+func (uc *taskUseCase) BulkTask(req *dto.BulkTaskReqDTO) (*dto.BulkTaskRespDTO, error) {
+	if req.Mode == "" {
+		req.Mode = dto.BulkModeAtomic
+	}
+	if slices.Contains(req.Operations, nil) {
+		return nil, common_error.NewError(common_error.DATA_INVALID, errors.New("operations: cannot contain null"))
+	}
+
+	results := make([]*dto.BulkTaskResultDTO, len(req.Operations))
+	pending := make([]*dto.BulkOperationDTO, 0, len(req.Operations))
+	for i, op := range req.Operations {
+		op.Index = i
+		if err := uc.prepareBulkOperation(op, req.UserID); err != nil {
+			results[i] = &dto.BulkTaskResultDTO{Index: i, ID: op.ID, Op: op.Op, Status: dto.BulkStatusFailed, Err: err}
+			continue
+		}
+		pending = append(pending, op)
+	}
+
+	committed := false
+	if req.Mode == dto.BulkModeAtomic && len(pending) < len(req.Operations) {
+		for _, op := range pending {
+			results[op.Index] = &dto.BulkTaskResultDTO{Index: op.Index, ID: op.ID, Op: op.Op, Status: dto.BulkStatusSkipped}
+		}
+	} else if len(pending) > 0 {
+		resp, err := uc.Repo.BulkTask(&dto.BulkTaskReqDTO{UserID: req.UserID, Mode: req.Mode, Operations: pending, Audit: req.Audit})
+		if err != nil && !errors.Is(err, repo.ErrBulkAborted) {
+			return nil, err
+		}
+		committed = err == nil
+
+		for _, result := range resp {
+			results[result.Index] = result
+		}
+	}
+
+	if committed {
+		uc.afterBulk(req, results)
+	}
+	return bulkResponse(req.Mode, committed, results), nil
+}
+
+// prepareBulkOperation memastikan user boleh menjalankan operasi dan mengisi pemilik task.
+// Restore hanya berlaku untuk task milik user karena trash tidak dibagikan.
+func (uc *taskUseCase) prepareBulkOperation(op *dto.BulkOperationDTO, userID int64) error {
+	if op.Op == dto.BulkRestore {
+		op.UserID = userID
+		return nil
+	}
+
+	permission := shareDto.PermissionEditor
+	if op.Op == dto.BulkDelete {
+		permission = shareDto.PermissionOwner
+	}
+
+	task, err := uc.getTask(op.ID, userID, permission)
+	if err != nil {
+		return err
+	}
+	op.UserID = task.Owner.ID // Operasi disimpan atas nama pemilik task
+
+	switch op.Op {
+	case dto.BulkFinish:
+		if !canTransition(task.Status, dto.TaskEventFinish) {
+			return invalidTransition(task.Status, dto.TaskEventFinish)
+		}
+		if len(task.BlockedBy) > 0 {
+			return common_error.NewError(common_error.TASK_BLOCKED, errors.New("task is blocked by pending tasks"))
+		}
+		op.From = transitions[dto.TaskEventFinish].from
+	case dto.BulkSetProject:
+		if *op.ProjectID != 0 {
+			return uc.checkProject(*op.ProjectID, op.UserID)
+		}
+	}
+	return nil
+}
+
+// afterBulk menjalankan efek samping operasi bulk yang sudah tersimpan, mengirimkan
+// event tasktransition untuk setiap perpindahan status dan satu event taskbulk ke NATS
+func (uc *taskUseCase) afterBulk(req *dto.BulkTaskReqDTO, results []*dto.BulkTaskResultDTO) {
+	event := &dto.TaskBulkEventDTO{
+		ActorID:    req.Audit.ActorID,
+		RequestID:  req.Audit.RequestID,
+		Mode:       req.Mode,
+		OccurredAt: time.Now(),
+	}
+
+	for _, result := range results {
+		if result.Status != dto.BulkStatusOK {
+			continue
+		}
+		op := req.Operations[result.Index]
+		event.Operations = append(event.Operations, &dto.TaskBulkEventItem{
+			ID:         result.ID,
+			Op:         result.Op,
+			ProjectID:  op.ProjectID,
+			Priority:   op.Priority,
+			Transition: result.Transition,
+		})
+
+		// Subscriber tasktransition menerima perubahan status yang sama seperti operasi satuan
+		if result.Transition != nil {
+			uc.publishTransition(result.Transition)
+		}
+
+		switch result.Op {
+		case dto.BulkFinish, dto.BulkDelete:
+			// Task yang selesai atau dihapus tidak perlu dilacak kadaluarsanya
+			if err := uc.Expiry.Cancel(result.ID); err != nil {
+				log.Println(err)
+			}
+			if result.Op == dto.BulkFinish {
+				uc.spawnNextInstance(result.ID)
+			}
+		case dto.BulkRestore:
+			if isActive(result.Task.Status) {
+				if err := uc.Expiry.Schedule(result.ID, result.Task.ExpiresAt); err != nil {
+					log.Println(err)
+				}
+			}
+		}
+	}
+
+	if len(event.Operations) == 0 {
+		return
+	}
+
+	// Perubahan sudah tersimpan, sehingga kegagalan publish hanya dicatat
+	newData, _ := json.Marshal(event)
+	if err := uc.Publisher.Nats(newData, Const.TASK_BULK); err != nil {
+		log.Println(err)
+	}
+}
+
+// bulkResponse menyusun hasil bulk task beserta kode error untuk operasi yang gagal
+func bulkResponse(mode string, committed bool, results []*dto.BulkTaskResultDTO) *dto.BulkTaskRespDTO {
+	resp := &dto.BulkTaskRespDTO{Mode: mode, Committed: committed, Results: results}
+	for _, result := range results {
+		switch result.Status {
+		case dto.BulkStatusOK:
+			resp.Succeeded++
+		case dto.BulkStatusFailed:
+			resp.Failed++
+			result.Code, result.Message = bulkError(result.Err)
+		}
+	}
+	return resp
+}
+
+// bulkError mengubah error operasi bulk menjadi kode dan pesan yang dikenali client.
+// Error yang tidak dikenali tidak ditampilkan detailnya.
+func bulkError(err error) (uint, string) {
+	var commonErr *common_error.CommonError
+	if errors.As(taskError(err), &commonErr) {
+		return uint(commonErr.ErrorCode), commonErr.ClientMessage
+	}
+	return uint(common_error.UNKNOWN_ERROR), "Unknown error."
+}
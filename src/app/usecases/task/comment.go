@@ -0,0 +1,86 @@
+package task
+
+import (
+	"encoding/json"
+	"log"
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	Const "todo_list/src/infra/constants"
+	"todo_list/src/infra/helper"
+)
+
+// AddComment menambahkan komentar ke task yang bisa diakses user
+// dan mengirimkan event taskcommented ke NATS
+func (uc *taskUseCase) AddComment(req *dto.AddCommentReqDTO) (*dto.CommentRespDTO, error) {
+	task, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.Repo.AddComment(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Komentar sudah tersimpan, sehingga kegagalan publish hanya dicatat
+	newData, _ := json.Marshal(&dto.TaskCommentedEventDTO{
+		ID:          resp.ID,
+		TaskID:      task.ID,
+		TaskTitle:   task.Title,
+		TaskOwnerID: task.Owner.ID,
+		AuthorID:    resp.Author.ID,
+		AuthorName:  resp.Author.Name,
+		Body:        resp.Body,
+		CreatedAt:   resp.CreatedAt,
+	})
+	if err := uc.Publisher.Nats(newData, Const.TASK_COMMENTED); err != nil {
+		log.Println(err)
+	}
+	return resp, nil
+}
+
+// GetCommentList mengambil komentar task yang bisa diakses user beserta jumlah totalnya
+func (uc *taskUseCase) GetCommentList(req *dto.GetCommentListReqDTO) ([]*dto.CommentRespDTO, int64, error) {
+	// Gunakan paginasi default jika tidak diisi
+	if req.Page <= 0 {
+		req.Page = helper.Page
+	}
+	if req.PerPage <= 0 {
+		req.PerPage = helper.PerPage
+	}
+
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer); err != nil {
+		return nil, 0, err
+	}
+
+	resp, total, err := uc.Repo.GetCommentList(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp, total, nil
+}
+
+// UpdateComment mengubah komentar yang ditulis user sendiri
+func (uc *taskUseCase) UpdateComment(req *dto.UpdateCommentReqDTO) (*dto.CommentRespDTO, error) {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer); err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.Repo.UpdateComment(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return resp, nil
+}
+
+// DeleteComment menghapus komentar yang ditulis user sendiri
+func (uc *taskUseCase) DeleteComment(req *dto.DeleteCommentReqDTO) error {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer); err != nil {
+		return err
+	}
+
+	if err := uc.Repo.DeleteComment(req); err != nil {
+		return taskError(err)
+	}
+	return nil
+}
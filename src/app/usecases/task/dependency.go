@@ -0,0 +1,38 @@
+package task
+
+import (
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+)
+
+// AddDependency menandai task bergantung pada task lain milik pemilik yang sama
+// dan mengembalikan task dengan daftar blocked_by terbaru
+func (uc *taskUseCase) AddDependency(req *dto.AddDependencyReqDTO) (*dto.GetTaskRespDTO, error) {
+	userID := req.UserID
+	task, err := uc.getTask(req.TaskID, userID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	req.UserID = task.Owner.ID
+
+	if err := uc.Repo.AddDependency(req); err != nil {
+		return nil, taskError(err)
+	}
+	return uc.getTask(req.TaskID, userID, shareDto.PermissionViewer)
+}
+
+// RemoveDependency menghapus ketergantungan task
+// dan mengembalikan task dengan daftar blocked_by terbaru
+func (uc *taskUseCase) RemoveDependency(req *dto.RemoveDependencyReqDTO) (*dto.GetTaskRespDTO, error) {
+	userID := req.UserID
+	task, err := uc.getTask(req.TaskID, userID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	req.UserID = task.Owner.ID
+
+	if err := uc.Repo.RemoveDependency(req); err != nil {
+		return nil, taskError(err)
+	}
+	return uc.getTask(req.TaskID, userID, shareDto.PermissionViewer)
+}
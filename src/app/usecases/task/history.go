@@ -0,0 +1,28 @@
+package task
+
+import (
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	"todo_list/src/infra/helper"
+)
+
+// GetTaskHistory mengambil riwayat perubahan task yang bisa diakses user beserta jumlah totalnya
+func (uc *taskUseCase) GetTaskHistory(req *dto.GetTaskHistoryReqDTO) ([]*dto.TaskEventRespDTO, int64, error) {
+	// Gunakan paginasi default jika tidak diisi
+	if req.Page <= 0 {
+		req.Page = helper.Page
+	}
+	if req.PerPage <= 0 {
+		req.PerPage = helper.PerPage
+	}
+
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer); err != nil {
+		return nil, 0, err
+	}
+
+	resp, total, err := uc.Repo.GetTaskHistory(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp, total, nil
+}
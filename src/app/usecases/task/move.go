@@ -0,0 +1,42 @@
+package task
+
+import (
+	"errors"
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// MoveTask memindahkan task yang bisa diubah user pada urutan manual.
+// Urutan manual disimpan per pemilik, sehingga task before dan after harus
+// terlihat oleh user dan dimiliki oleh pemilik yang sama.
+func (uc *taskUseCase) MoveTask(req *dto.MoveTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	task, err := uc.getTask(req.ID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range []*int64{req.After, req.Before} {
+		if id == nil {
+			continue
+		}
+
+		neighbour, err := uc.getTask(*id, req.UserID, shareDto.PermissionViewer)
+		if err != nil {
+			return nil, err
+		}
+		if neighbour.Owner.ID != task.Owner.ID {
+			return nil, common_error.NewError(common_error.DATA_INVALID, errors.New("before: neighbour task must belong to the same owner"))
+		}
+	}
+
+	req.UserID = task.Owner.ID // Task diurutkan pada daftar milik pemiliknya
+
+	resp, err := uc.Repo.MoveTask(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	resp.Permission = task.Permission
+
+	return resp, nil
+}
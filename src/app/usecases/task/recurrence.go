@@ -0,0 +1,118 @@
+package task
+
+import (
+	"log"
+	"time"
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	"todo_list/src/infra/rrule"
+)
+
+// GetRecurrence mengambil seri berulang dari task yang bisa diakses user
+func (uc *taskUseCase) GetRecurrence(req *dto.GetRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error) {
+	task, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	req.UserID = task.Owner.ID
+
+	resp, err := uc.Repo.GetRecurrence(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return resp, nil
+}
+
+// UpdateRecurrence mengubah aturan dan template seri berulang dari task yang bisa diubah user
+func (uc *taskUseCase) UpdateRecurrence(req *dto.UpdateRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error) {
+	task, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	req.UserID = task.Owner.ID
+
+	resp, err := uc.Repo.UpdateRecurrence(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return resp, nil
+}
+
+// StopRecurrence menghentikan seri berulang, instance yang sedang berjalan tidak diubah
+func (uc *taskUseCase) StopRecurrence(req *dto.StopRecurrenceReqDTO) error {
+	task, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return err
+	}
+	req.UserID = task.Owner.ID
+
+	if err := uc.Repo.StopRecurrence(req); err != nil {
+		return taskError(err)
+	}
+	return nil
+}
+
+// spawnNextInstance membuat instance berikutnya ketika instance terbaru dari seri
+// berulang selesai atau expired. Status task sudah tersimpan, sehingga kegagalan
+// di sini hanya dicatat.
+func (uc *taskUseCase) spawnNextInstance(taskID int64) {
+	rec, err := uc.Repo.GetTaskRecurrence(taskID)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+
+	// Bukan task berulang, seri sudah dihentikan, atau instance berikutnya sudah ada
+	if rec == nil || !rec.Active || rec.LastTaskID == nil || *rec.LastTaskID != taskID {
+		return
+	}
+
+	next, ok, err := nextOccurrence(rec, time.Now())
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if !ok {
+		return // Seri sudah selesai karena COUNT atau UNTIL
+	}
+
+	id, err := uc.Repo.AddNextInstance(&dto.NextInstanceReqDTO{
+		RecurrenceID: rec.ID,
+		PrevTaskID:   taskID,
+		ExpiresAt:    next,
+	})
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	if id == 0 {
+		return
+	}
+
+	if err := uc.Expiry.Schedule(id, next); err != nil {
+		log.Println(err)
+	}
+}
+
+// nextOccurrence menghitung expires_at instance berikutnya di zona waktu seri.
+// Instance yang selesai terlambat tidak menghasilkan instance yang langsung lewat waktu.
+// Hasilnya dalam UTC karena kolom expires_at tidak menyimpan zona waktu.
+func nextOccurrence(rec *dto.TaskRecurrenceDTO, now time.Time) (time.Time, bool, error) {
+	loc, err := time.LoadLocation(rec.Timezone)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	rule, err := rrule.ParseIn(rec.RRule, loc)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	after := rec.TaskExpiresAt
+	if now.After(after) {
+		after = now
+	}
+
+	next, ok := rule.Next(rec.DTStart.In(loc), after)
+	return next.UTC(), ok, nil
+}
@@ -0,0 +1,41 @@
+package task
+
+import (
+	"encoding/json"
+	"log"
+	Const "todo_list/src/infra/constants"
+)
+
+// SendDueReminders mengirimkan satu batch pengingat yang jatuh tempo ke NATS
+// dan mengembalikan jumlah pengingat yang diproses. Pengingat baru ditandai terkirim
+// setelah publish berhasil, pengingat yang gagal dikirim dikembalikan ke antrian
+// agar dicoba lagi pada putaran berikutnya.
+func (uc *taskUseCase) SendDueReminders(limit int) (int, error) {
+	events, err := uc.Repo.ClaimDueReminders(limit) // Klaim pengingat jatuh tempo dengan lease
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	for i, event := range events {
+		newData, _ := json.Marshal(event)
+		if err := uc.Publisher.Nats(newData, Const.TASK_REMINDER); err != nil {
+			log.Println(err)
+
+			// NATS tidak tersedia, sisa batch tidak perlu dicoba sekarang
+			for _, pending := range events[i:] {
+				if err := uc.Repo.ReleaseReminder(pending.ID); err != nil {
+					log.Println(err)
+				}
+			}
+			return i, err
+		}
+
+		// Jika penandaan gagal, lease klaim habis dan pengingat dikirim ulang.
+		// Consumer bisa mengenali pengiriman ganda dari id pengingat.
+		if err := uc.Repo.MarkReminderSent(event.ID); err != nil {
+			log.Println(err)
+		}
+	}
+	return len(events), nil
+}
@@ -0,0 +1,42 @@
+package task
+
+import (
+	dto "todo_list/src/app/dto/share"
+)
+
+// ShareTask membagikan task ke user lain berdasarkan email, hanya pemilik yang boleh membagikan
+func (uc *taskUseCase) ShareTask(req *dto.ShareReqDTO) (*dto.ShareRespDTO, error) {
+	if _, err := uc.getTask(req.ID, req.UserID, dto.PermissionOwner); err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.Repo.ShareTask(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return resp, nil
+}
+
+// GetTaskShares mengambil daftar kolaborator task, bisa dilihat semua user yang punya akses
+func (uc *taskUseCase) GetTaskShares(req *dto.GetShareListReqDTO) ([]*dto.ShareRespDTO, error) {
+	task, err := uc.getTask(req.ID, req.UserID, dto.PermissionViewer)
+	if err != nil {
+		return nil, err
+	}
+	req.UserID = task.Owner.ID
+
+	resp, err := uc.Repo.GetTaskShares(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// RemoveTaskShare mencabut akses kolaborator, dilakukan oleh pemilik task
+// atau oleh kolaborator itu sendiri untuk keluar dari task
+func (uc *taskUseCase) RemoveTaskShare(req *dto.RemoveShareReqDTO) error {
+	if err := uc.Repo.RemoveTaskShare(req); err != nil {
+		return taskError(err)
+	}
+	return nil
+}
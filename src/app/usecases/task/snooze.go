@@ -0,0 +1,92 @@
+package task
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"time"
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// snoozable adalah status task yang boleh di-snooze, task expired dikembalikan menjadi pending
+var snoozable = []string{dto.StatusPending, dto.StatusInProgress, dto.StatusExpired}
+
+// SnoozeTask mengundur expires_at task yang bisa diubah user, menambah snooze_count,
+// dan melacak kembali kadaluarsanya. Task yang sudah expired kembali menjadi pending.
+func (uc *taskUseCase) SnoozeTask(req *dto.SnoozeTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	task, err := uc.getTask(req.ID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(snoozable, task.Status) {
+		return nil, invalidTransition(task.Status, dto.TaskEventSnooze)
+	}
+
+	now := time.Now()
+	expiresAt := snoozeUntil(req, now)
+	if !expiresAt.After(now) || !expiresAt.After(task.ExpiresAt) {
+		return nil, common_error.NewError(common_error.DATA_INVALID, errors.New("until: must be later than now and the current expires_at"))
+	}
+
+	req.UserID = task.Owner.ID // Task di-snooze atas nama pemiliknya
+	req.ExpiresAt = expiresAt
+	req.From = snoozable
+
+	resp, from, err := uc.Repo.SnoozeTask(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	resp.Permission = task.Permission
+
+	// Task expired yang dihidupkan kembali tercatat sebagai perpindahan status
+	if from != resp.Status {
+		uc.publishTransition(&dto.TaskTransitionEventDTO{
+			TaskID:     resp.ID,
+			UserID:     resp.Owner.ID,
+			Title:      resp.Title,
+			Action:     dto.TaskEventSnooze,
+			From:       from,
+			To:         resp.Status,
+			ActorID:    req.Audit.ActorID,
+			RequestID:  req.Audit.RequestID,
+			OccurredAt: resp.UpdatedAt,
+		})
+	}
+
+	if err := uc.Expiry.Schedule(resp.ID, resp.ExpiresAt); err != nil {
+		log.Println(err)
+	}
+	return resp, nil
+}
+
+// snoozeUntil menghitung expires_at baru dari preset atau until.
+// Hasilnya dalam UTC karena kolom expires_at tidak menyimpan zona waktu.
+func snoozeUntil(req *dto.SnoozeTaskReqDTO, now time.Time) time.Time {
+	if req.Until != nil {
+		return req.Until.UTC()
+	}
+
+	// Zona waktu sudah divalidasi di DTO
+	loc := time.UTC
+	if req.Timezone != "" {
+		if l, err := time.LoadLocation(req.Timezone); err == nil {
+			loc = l
+		}
+	}
+	local := now.In(loc)
+
+	switch req.Preset {
+	case dto.SnoozeTomorrow:
+		return time.Date(local.Year(), local.Month(), local.Day()+1, dto.SnoozeHour, 0, 0, 0, loc).UTC()
+	case dto.SnoozeNextWeek:
+		days := (int(time.Monday) - int(local.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return time.Date(local.Year(), local.Month(), local.Day()+days, dto.SnoozeHour, 0, 0, 0, loc).UTC()
+	default:
+		return now.Add(time.Hour).UTC()
+	}
+}
@@ -0,0 +1,129 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"slices"
+	"time"
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+	Const "todo_list/src/infra/constants"
+	common_error "todo_list/src/infra/errors"
+)
+
+// transition adalah perpindahan status task yang diizinkan
+type transition struct {
+	from []string
+	to   string
+}
+
+// transitions adalah state machine status task, key-nya adalah aksi perpindahan.
+// Repository hanya memindahkan status jika status saat ini termasuk from.
+var transitions = map[string]transition{
+	dto.TaskEventStart:  {from: []string{dto.StatusPending}, to: dto.StatusInProgress},
+	dto.TaskEventFinish: {from: []string{dto.StatusPending, dto.StatusInProgress}, to: dto.StatusDone},
+	dto.TaskEventCancel: {from: []string{dto.StatusPending, dto.StatusInProgress}, to: dto.StatusCancelled},
+	dto.TaskEventReopen: {from: []string{dto.StatusDone}, to: dto.StatusPending},
+	dto.TaskEventExpire: {from: []string{dto.StatusPending, dto.StatusInProgress}, to: dto.StatusExpired},
+}
+
+// canTransition mengembalikan true jika task dengan status tersebut boleh menjalankan aksi
+func canTransition(status string, action string) bool {
+	t, ok := transitions[action]
+	return ok && slices.Contains(t.from, status)
+}
+
+// isActive mengembalikan true jika task masih berjalan sehingga perlu dilacak kadaluarsanya
+func isActive(status string) bool {
+	return canTransition(status, dto.TaskEventExpire)
+}
+
+// invalidTransition membuat error untuk perpindahan status yang tidak diizinkan
+func invalidTransition(status string, action string) error {
+	return common_error.NewError(common_error.INVALID_TRANSITION, errors.New("cannot "+action+" a task that is "+status))
+}
+
+// TransitionTask menjalankan perpindahan status start, cancel, atau reopen pada task yang bisa diubah user
+// dan mengirimkan event tasktransition ke NATS
+func (uc *taskUseCase) TransitionTask(req *dto.TransitionTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	t, ok := transitions[req.Action]
+	if !ok || req.Action == dto.TaskEventFinish || req.Action == dto.TaskEventExpire {
+		return nil, common_error.NewError(common_error.DATA_INVALID, errors.New("action: must be start, cancel, or reopen"))
+	}
+
+	task, err := uc.getTask(req.ID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	if !canTransition(task.Status, req.Action) {
+		return nil, invalidTransition(task.Status, req.Action)
+	}
+
+	// Task yang dibuka kembali dengan expires_at yang sudah lewat akan langsung expired lagi,
+	// sehingga reopen seperti itu membutuhkan expires_at baru
+	expiresAt := task.ExpiresAt
+	if req.ExpiresAt != nil {
+		if req.Action != dto.TaskEventReopen {
+			return nil, common_error.NewError(common_error.DATA_INVALID, errors.New("expires_at: only supported when reopening a task"))
+		}
+		if !req.ExpiresAt.After(time.Now()) {
+			return nil, common_error.NewError(common_error.DATA_INVALID, errors.New("expires_at: must be in the future"))
+		}
+		utc := req.ExpiresAt.UTC() // Kolom expires_at tidak menyimpan zona waktu
+		req.ExpiresAt = &utc
+		expiresAt = utc
+	} else if req.Action == dto.TaskEventReopen && !task.ExpiresAt.After(time.Now()) {
+		return nil, common_error.NewError(common_error.INVALID_TRANSITION, errors.New("cannot reopen a task whose expires_at has passed without a new expires_at"))
+	}
+
+	// Status disimpan atas nama pemilik task
+	viewerID := req.UserID
+	req.UserID = task.Owner.ID
+	req.From = t.from
+	req.To = t.to
+
+	event, err := uc.Repo.TransitionTask(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	uc.publishTransition(event)
+
+	switch event.To {
+	case dto.StatusCancelled:
+		// Task yang dibatalkan tidak perlu dilacak kadaluarsanya, seri berulang tetap berlanjut
+		if err := uc.Expiry.Cancel(event.TaskID); err != nil {
+			log.Println(err)
+		}
+		uc.spawnNextInstance(event.TaskID)
+	case dto.StatusPending:
+		// Task yang dibuka kembali dilacak lagi kadaluarsanya
+		if err := uc.Expiry.Schedule(event.TaskID, expiresAt); err != nil {
+			log.Println(err)
+		}
+	}
+
+	return uc.getTask(req.ID, viewerID, shareDto.PermissionViewer)
+}
+
+// publishTransition mengirimkan event perpindahan status task ke NATS.
+// Status task sudah tersimpan, sehingga kegagalan publish hanya dicatat.
+func (uc *taskUseCase) publishTransition(event *dto.TaskTransitionEventDTO) {
+	newData, _ := json.Marshal(event)
+	if err := uc.Publisher.Nats(newData, Const.TASK_TRANSITION); err != nil {
+		log.Println(err)
+	}
+}
+
+// expiredTransition membuat event perpindahan status dari event task expired
+func expiredTransition(event *dto.TaskExpiredEventDTO) *dto.TaskTransitionEventDTO {
+	return &dto.TaskTransitionEventDTO{
+		TaskID:     event.ID,
+		UserID:     event.UserID,
+		Title:      event.Title,
+		Action:     dto.TaskEventExpire,
+		From:       event.PreviousStatus,
+		To:         dto.StatusExpired,
+		OccurredAt: event.UpdatedAt,
+	}
+}
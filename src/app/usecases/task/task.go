@@ -2,37 +2,100 @@ package task
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
 	"log"
-	dto "todo_list/src/app/dto/task"                   // Import DTO untuk Task
-	repo "todo_list/src/app/repositories/task"        // Import repository Task
+	"time"
+	shareDto "todo_list/src/app/dto/share"                    // Import DTO untuk berbagi task
+	dto "todo_list/src/app/dto/task"                          // Import DTO untuk Task
+	repo "todo_list/src/app/repositories/task"                // Import repository Task
 	natsPublisher "todo_list/src/infra/broker/nats/publisher" // Import publisher NATS
-	Const "todo_list/src/infra/constants"             // Import constants
+	Const "todo_list/src/infra/constants"                     // Import constants
+	common_error "todo_list/src/infra/errors"                 // Import custom error
+	"todo_list/src/infra/helper"                              // Import helper paginasi
+	"todo_list/src/infra/persistence/blob"                    // Import penyimpanan lampiran
+	redis "todo_list/src/infra/persistence/redis"             // Import pelacak kadaluarsa Redis
 )
 
 // TaskUCInterface mendefinisikan contract untuk Task Use Case
 type TaskUCInterface interface {
 	AddTask(req *dto.CreateTaskReqDTO) error
 	FinishTask(req *dto.FinishtTaskReqDTO) error
-	GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error)
+	UpdateTask(req *dto.UpdateTaskReqDTO) (*dto.GetTaskRespDTO, error)
+	GetTaskList(req *dto.GetTaskReqDTO) (*dto.GetTaskListRespDTO, error)
+	SearchTask(req *dto.SearchTaskReqDTO) ([]*dto.SearchTaskRespDTO, int64, error)
+	SaveTask(req *dto.CreateTaskReqDTO) error
+	SaveFinishTask(req *dto.FinishtTaskReqDTO) error
+	ExpireTask(req *dto.ExpireTaskReqDTO) error
+	ExpireOverdueTasks(limit int) (int, error)
+	DeleteTask(req *dto.DeleteTaskReqDTO) error
+	RestoreTask(req *dto.RestoreTaskReqDTO) (*dto.GetTaskRespDTO, error)
+	GetTrashList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error)
+	PurgeTrash(retention time.Duration) (int, error)
+	GetChecklist(req *dto.GetChecklistReqDTO) ([]*dto.ChecklistItemRespDTO, error)
+	AddChecklistItem(req *dto.AddChecklistItemReqDTO) (*dto.ChecklistItemRespDTO, error)
+	CheckChecklistItem(req *dto.CheckChecklistItemReqDTO) (*dto.ChecklistSummaryDTO, error)
+	ReorderChecklist(req *dto.ReorderChecklistReqDTO) error
+	DeleteChecklistItem(req *dto.DeleteChecklistItemReqDTO) error
+	GetRecurrence(req *dto.GetRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error)
+	UpdateRecurrence(req *dto.UpdateRecurrenceReqDTO) (*dto.RecurrenceRespDTO, error)
+	StopRecurrence(req *dto.StopRecurrenceReqDTO) error
+	SendDueReminders(limit int) (int, error)
+	AddDependency(req *dto.AddDependencyReqDTO) (*dto.GetTaskRespDTO, error)
+	RemoveDependency(req *dto.RemoveDependencyReqDTO) (*dto.GetTaskRespDTO, error)
+	ShareTask(req *shareDto.ShareReqDTO) (*shareDto.ShareRespDTO, error)
+	GetTaskShares(req *shareDto.GetShareListReqDTO) ([]*shareDto.ShareRespDTO, error)
+	RemoveTaskShare(req *shareDto.RemoveShareReqDTO) error
+	AddComment(req *dto.AddCommentReqDTO) (*dto.CommentRespDTO, error)
+	GetCommentList(req *dto.GetCommentListReqDTO) ([]*dto.CommentRespDTO, int64, error)
+	UpdateComment(req *dto.UpdateCommentReqDTO) (*dto.CommentRespDTO, error)
+	DeleteComment(req *dto.DeleteCommentReqDTO) error
+	AddAttachment(req *dto.AddAttachmentReqDTO) (*dto.AttachmentRespDTO, error)
+	GetAttachmentList(req *dto.GetAttachmentListReqDTO) ([]*dto.AttachmentRespDTO, error)
+	GetAttachment(req *dto.GetAttachmentReqDTO) (*dto.AttachmentRespDTO, io.ReadCloser, error)
+	DeleteAttachment(req *dto.DeleteAttachmentReqDTO) error
+	GetTaskHistory(req *dto.GetTaskHistoryReqDTO) ([]*dto.TaskEventRespDTO, int64, error)
+	TransitionTask(req *dto.TransitionTaskReqDTO) (*dto.GetTaskRespDTO, error)
+	BulkTask(req *dto.BulkTaskReqDTO) (*dto.BulkTaskRespDTO, error)
+	StartTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error)
+	StopTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error)
+	GetTimeReport(req *dto.GetTimeReportReqDTO) (*dto.TimeReportRespDTO, error)
+	SnoozeTask(req *dto.SnoozeTaskReqDTO) (*dto.GetTaskRespDTO, error)
+	MoveTask(req *dto.MoveTaskReqDTO) (*dto.GetTaskRespDTO, error)
 }
 
 // taskUseCase adalah implementasi dari TaskUCInterface
 type taskUseCase struct {
 	Publisher natsPublisher.PublisherInterface // Publisher untuk event NATS
 	Repo      repo.TaskRepository              // Repository untuk mengakses database
+	Expiry    redis.TaskExpiryInterface        // Pelacak kadaluarsa task berbasis TTL Redis
+	Blob      blob.BlobStore                   // Penyimpanan file lampiran
+	Limits    blob.Limits                      // Batas ukuran dan kuota lampiran
 }
 
 // NewTaskUseCase membuat instance taskUseCase
-func NewTaskUseCase(p natsPublisher.PublisherInterface, r repo.TaskRepository) TaskUCInterface {
+func NewTaskUseCase(p natsPublisher.PublisherInterface, r repo.TaskRepository, e redis.TaskExpiryInterface, b blob.BlobStore, l blob.Limits) TaskUCInterface {
 	return &taskUseCase{
 		Publisher: p,
 		Repo:      r,
+		Expiry:    e,
+		Blob:      b,
+		Limits:    l,
 	}
 }
 
 // AddTask mengirimkan task baru ke NATS
 func (uc *taskUseCase) AddTask(req *dto.CreateTaskReqDTO) error {
-	newData, _ := json.Marshal(req)               // Serialize request ke JSON
+	req.Tags = helper.NormalizeTags(req.Tags)
+
+	// Pastikan project tujuan milik user sebelum diproses secara async
+	if req.ProjectID != nil {
+		if err := uc.checkProject(*req.ProjectID, req.UserID); err != nil {
+			return err
+		}
+	}
+
+	newData, _ := json.Marshal(req)                   // Serialize request ke JSON
 	err := uc.Publisher.Nats(newData, Const.ADD_TASK) // Kirim ke NATS
 	if err != nil {
 		log.Println(err)
@@ -43,8 +106,24 @@ func (uc *taskUseCase) AddTask(req *dto.CreateTaskReqDTO) error {
 
 // FinishTask mengirimkan event selesai task ke NATS
 func (uc *taskUseCase) FinishTask(req *dto.FinishtTaskReqDTO) error {
-	newData, _ := json.Marshal(req)                  // Serialize request ke JSON
-	err := uc.Publisher.Nats(newData, Const.FINISH_TASK) // Kirim ke NATS
+	// Pastikan user boleh mengubah task sebelum diproses secara async
+	task, err := uc.getTask(req.ID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return err
+	}
+	req.UserID = task.Owner.ID // Task diselesaikan atas nama pemiliknya
+
+	if !canTransition(task.Status, dto.TaskEventFinish) {
+		return invalidTransition(task.Status, dto.TaskEventFinish)
+	}
+
+	// Task tidak bisa diselesaikan selama masih ada task yang ditunggu
+	if len(task.BlockedBy) > 0 {
+		return common_error.NewError(common_error.TASK_BLOCKED, errors.New("task is blocked by pending tasks"))
+	}
+
+	newData, _ := json.Marshal(req)                     // Serialize request ke JSON
+	err = uc.Publisher.Nats(newData, Const.FINISH_TASK) // Kirim ke NATS
 	if err != nil {
 		log.Println(err)
 		return err
@@ -52,11 +131,395 @@ func (uc *taskUseCase) FinishTask(req *dto.FinishtTaskReqDTO) error {
 	return nil
 }
 
-// GetTaskList mengambil daftar task dari repository
-func (uc *taskUseCase) GetTaskList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error) {
-	resp, err := uc.Repo.GetTaskList(req) // Ambil data task dari repository
+// UpdateTask memperbarui task yang bisa diubah user dan menjadwalkan ulang kadaluarsanya
+func (uc *taskUseCase) UpdateTask(req *dto.UpdateTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	task, err := uc.getTask(req.ID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+	req.UserID = task.Owner.ID // Tag dan project mengikuti pemilik task
+
+	if req.Tags != nil {
+		tags := helper.NormalizeTags(*req.Tags)
+		req.Tags = &tags
+	}
+
+	// Task hanya boleh dipindahkan ke project milik user, 0 berarti keluar dari project
+	if req.ProjectID != nil && *req.ProjectID != 0 {
+		if err := uc.checkProject(*req.ProjectID, req.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ExpiresAt != nil {
+		utc := req.ExpiresAt.UTC() // Kolom expires_at tidak menyimpan zona waktu
+		req.ExpiresAt = &utc
+	}
+
+	resp, err := uc.Repo.UpdateTask(req) // Simpan perubahan ke database
+	if err != nil {
+		return nil, taskError(err)
+	}
+	resp.Permission = task.Permission
+
+	// Hanya task yang masih berjalan yang perlu dilacak kadaluarsanya
+	if req.ExpiresAt != nil && isActive(resp.Status) {
+		if err := uc.Expiry.Schedule(resp.ID, resp.ExpiresAt); err != nil {
+			log.Println(err)
+		}
+	}
+	return resp, nil
+}
+
+// GetTaskList mengambil daftar task dari repository beserta jumlah totalnya
+// dan cursor untuk halaman berikutnya
+func (uc *taskUseCase) GetTaskList(req *dto.GetTaskReqDTO) (*dto.GetTaskListRespDTO, error) {
+	// Gunakan paginasi default jika tidak diisi
+	if req.Page <= 0 {
+		req.Page = helper.Page
+	}
+	if req.PerPage <= 0 {
+		req.PerPage = helper.PerPage
+	}
+	req.Tags = helper.NormalizeTags(req.Tags)
+
+	// Project yang dibagikan ke user juga boleh dipakai sebagai filter
+	if req.ProjectID != nil && *req.ProjectID != 0 {
+		if err := uc.Repo.ProjectVisible(*req.ProjectID, req.UserID); err != nil {
+			return nil, taskError(err)
+		}
+	}
+
+	tasks, total, err := uc.Repo.GetTaskList(req) // Ambil data task dari repository
 	if err != nil {
 		return nil, err
 	}
+
+	resp := &dto.GetTaskListRespDTO{Tasks: tasks, Total: total}
+
+	// Halaman penuh berarti kemungkinan masih ada data berikutnya
+	if req.UseCursor() && len(tasks) > 0 && int64(len(tasks)) == req.PerPage {
+		last := tasks[len(tasks)-1]
+		resp.NextCursor = helper.EncodeCursor(last.ExpiresAt, last.ID)
+	}
 	return resp, nil
 }
+
+// SearchTask mencari task yang bisa diakses user berdasarkan title dan description
+func (uc *taskUseCase) SearchTask(req *dto.SearchTaskReqDTO) ([]*dto.SearchTaskRespDTO, int64, error) {
+	// Gunakan paginasi default jika tidak diisi
+	if req.Page <= 0 {
+		req.Page = helper.Page
+	}
+	if req.PerPage <= 0 {
+		req.PerPage = helper.PerPage
+	}
+
+	resp, total, err := uc.Repo.SearchTask(req) // Cari task di repository
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp, total, nil
+}
+
+// SaveTask menyimpan task baru yang diterima dari consumer NATS
+func (uc *taskUseCase) SaveTask(req *dto.CreateTaskReqDTO) error {
+	// Kolom expires_at dan dtstart seri berulang tidak menyimpan zona waktu
+	req.ExpiresAt = req.ExpiresAt.UTC()
+
+	id, err := uc.Repo.AddTask(req) // Simpan task ke database
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	// Jadwalkan kadaluarsa task, kegagalan Redis tidak membatalkan task yang sudah tersimpan
+	if err := uc.Expiry.Schedule(id, req.ExpiresAt); err != nil {
+		log.Println(err)
+	}
+	return nil
+}
+
+// SaveFinishTask menyimpan penyelesaian task yang diterima dari consumer NATS
+// dan mengirimkan event tasktransition
+func (uc *taskUseCase) SaveFinishTask(req *dto.FinishtTaskReqDTO) error {
+	// Status dan penghalang task bisa sudah berubah sejak pesan dikirim, sehingga dicek ulang oleh repository
+	req.From = transitions[dto.TaskEventFinish].from
+
+	event, err := uc.Repo.FinishTask(req) // Ubah status task di database
+	if errors.Is(err, repo.ErrTaskBlocked) {
+		return common_error.NewError(common_error.TASK_BLOCKED, err)
+	}
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+	uc.publishTransition(event)
+
+	// Task yang sudah selesai tidak perlu dilacak kadaluarsanya lagi
+	if err := uc.Expiry.Cancel(req.ID); err != nil {
+		log.Println(err)
+	}
+
+	uc.spawnNextInstance(req.ID)
+	return nil
+}
+
+// ExpireTask mengubah status task menjadi expired ketika TTL-nya habis
+func (uc *taskUseCase) ExpireTask(req *dto.ExpireTaskReqDTO) error {
+	event, err := uc.Repo.ExpireTask(req) // Ubah status task di database
+	if err != nil {
+		log.Println(err)
+		return err
+	}
+
+	// Task sudah selesai atau sudah expired sebelumnya
+	if event == nil {
+		return nil
+	}
+
+	uc.publishExpired(event)
+	uc.publishTransition(expiredTransition(event))
+	uc.spawnNextInstance(event.ID)
+	return nil
+}
+
+// ExpireOverdueTasks menjalankan satu batch sweeper kadaluarsa berbasis database
+// dan mengembalikan jumlah task yang berubah menjadi expired
+func (uc *taskUseCase) ExpireOverdueTasks(limit int) (int, error) {
+	events, err := uc.Repo.ExpireOverdueTasks(limit) // Ubah status task yang lewat waktu
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	for _, event := range events {
+		uc.publishExpired(event)
+		uc.publishTransition(expiredTransition(event))
+		uc.spawnNextInstance(event.ID)
+	}
+	return len(events), nil
+}
+
+// DeleteTask memindahkan task milik user ke trash, hanya pemilik yang boleh menghapus
+func (uc *taskUseCase) DeleteTask(req *dto.DeleteTaskReqDTO) error {
+	if _, err := uc.getTask(req.ID, req.UserID, shareDto.PermissionOwner); err != nil {
+		return err
+	}
+
+	if err := uc.Repo.DeleteTask(req); err != nil {
+		return taskError(err)
+	}
+
+	// Task di trash tidak perlu dilacak kadaluarsanya
+	if err := uc.Expiry.Cancel(req.ID); err != nil {
+		log.Println(err)
+	}
+	return nil
+}
+
+// RestoreTask mengembalikan task milik user dari trash
+func (uc *taskUseCase) RestoreTask(req *dto.RestoreTaskReqDTO) (*dto.GetTaskRespDTO, error) {
+	resp, err := uc.Repo.RestoreTask(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	// Lacak kembali kadaluarsa task yang masih berjalan
+	if isActive(resp.Status) {
+		if err := uc.Expiry.Schedule(resp.ID, resp.ExpiresAt); err != nil {
+			log.Println(err)
+		}
+	}
+	return resp, nil
+}
+
+// GetTrashList mengambil daftar task milik user yang ada di trash
+func (uc *taskUseCase) GetTrashList(req *dto.GetTaskReqDTO) ([]*dto.GetTaskRespDTO, error) {
+	resp, err := uc.Repo.GetTrashList(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PurgeTrash menghapus permanen task yang sudah berada di trash lebih lama dari retention
+// beserta file lampirannya
+func (uc *taskUseCase) PurgeTrash(retention time.Duration) (int, error) {
+	ids, err := uc.Repo.PurgeTrash(time.Now().Add(-retention))
+	if err != nil {
+		log.Println(err)
+		return 0, err
+	}
+
+	// Task sudah terhapus, file yang gagal dihapus hanya dicatat
+	for _, id := range ids {
+		if err := uc.Blob.DeletePrefix(attachmentPrefix(id)); err != nil {
+			log.Println(err)
+		}
+	}
+	return len(ids), nil
+}
+
+// GetChecklist mengambil checklist task yang bisa diakses user
+func (uc *taskUseCase) GetChecklist(req *dto.GetChecklistReqDTO) ([]*dto.ChecklistItemRespDTO, error) {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionViewer); err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.Repo.GetChecklist(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// AddChecklistItem menambahkan item checklist ke task yang bisa diubah user
+func (uc *taskUseCase) AddChecklistItem(req *dto.AddChecklistItemReqDTO) (*dto.ChecklistItemRespDTO, error) {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor); err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.Repo.AddChecklistItem(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// CheckChecklistItem menandai item checklist selesai atau belum.
+// Jika auto_finish aktif, semua item selesai, dan task tidak sedang menunggu task lain,
+// task diselesaikan melalui alur FINISH_TASK.
+func (uc *taskUseCase) CheckChecklistItem(req *dto.CheckChecklistItemReqDTO) (*dto.ChecklistSummaryDTO, error) {
+	task, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := uc.Repo.CheckChecklistItem(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	if req.Done && task.AutoFinish && canTransition(task.Status, dto.TaskEventFinish) && summary.Total > 0 && summary.Done == summary.Total &&
+		len(task.BlockedBy) == 0 {
+		newData, _ := json.Marshal(&dto.FinishtTaskReqDTO{ID: task.ID, UserID: task.Owner.ID, Audit: req.Audit})
+		if err := uc.Publisher.Nats(newData, Const.FINISH_TASK); err != nil {
+			log.Println(err)
+			return nil, err
+		}
+	}
+	return summary, nil
+}
+
+// ReorderChecklist mengubah urutan item checklist task yang bisa diubah user
+func (uc *taskUseCase) ReorderChecklist(req *dto.ReorderChecklistReqDTO) error {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor); err != nil {
+		return err
+	}
+
+	if err := uc.Repo.ReorderChecklist(req); err != nil {
+		return taskError(err)
+	}
+	return nil
+}
+
+// DeleteChecklistItem menghapus item checklist dari task yang bisa diubah user
+func (uc *taskUseCase) DeleteChecklistItem(req *dto.DeleteChecklistItemReqDTO) error {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor); err != nil {
+		return err
+	}
+
+	if err := uc.Repo.DeleteChecklistItem(req); err != nil {
+		return taskError(err)
+	}
+	return nil
+}
+
+// getTask mengambil task yang bisa diakses user dan memastikan hak aksesnya mencukupi.
+// Task milik user lain yang tidak dibagikan ke user dianggap tidak ada.
+func (uc *taskUseCase) getTask(id int64, userID int64, permission string) (*dto.GetTaskRespDTO, error) {
+	task, err := uc.Repo.GetTask(&dto.GetTaskByIDReqDTO{ID: id, UserID: userID})
+	if err != nil {
+		return nil, taskError(err)
+	}
+
+	if !shareDto.Allows(task.Permission, permission) {
+		return nil, common_error.NewError(common_error.PERMISSION_DENIED, errors.New("task requires "+permission+" permission"))
+	}
+	return task, nil
+}
+
+// checkProject memastikan project ada dan milik user
+func (uc *taskUseCase) checkProject(projectID int64, userID int64) error {
+	if err := uc.Repo.ProjectExists(projectID, userID); err != nil {
+		return taskError(err)
+	}
+	return nil
+}
+
+// taskError mengubah error not found dari repository menjadi error yang dikenali client
+func taskError(err error) error {
+	if errors.Is(err, repo.ErrTaskNotFound) {
+		return common_error.NewError(common_error.TASK_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrItemNotFound) {
+		return common_error.NewError(common_error.ITEM_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrIncompleteChecklist) {
+		return common_error.NewError(common_error.DATA_INVALID, err)
+	}
+	if errors.Is(err, repo.ErrProjectNotFound) {
+		return common_error.NewError(common_error.PROJECT_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrRecurrenceNotFound) {
+		return common_error.NewError(common_error.RECURRENCE_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrDependencyCycle) {
+		return common_error.NewError(common_error.DEPENDENCY_CYCLE, err)
+	}
+	if errors.Is(err, repo.ErrDependencyNotFound) {
+		return common_error.NewError(common_error.DEPENDENCY_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrUserNotFound) {
+		return common_error.NewError(common_error.USER_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrShareNotFound) {
+		return common_error.NewError(common_error.SHARE_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrCommentNotFound) {
+		return common_error.NewError(common_error.COMMENT_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrAttachmentNotFound) {
+		return common_error.NewError(common_error.ATTACHMENT_NOT_FOUND, err)
+	}
+	if errors.Is(err, repo.ErrQuotaExceeded) {
+		return common_error.NewError(common_error.QUOTA_EXCEEDED, err)
+	}
+	if errors.Is(err, repo.ErrInvalidTransition) {
+		return common_error.NewError(common_error.INVALID_TRANSITION, err)
+	}
+	if errors.Is(err, repo.ErrTaskBlocked) {
+		return common_error.NewError(common_error.TASK_BLOCKED, err)
+	}
+	if errors.Is(err, repo.ErrTimerRunning) {
+		return common_error.NewError(common_error.TIMER_RUNNING, err)
+	}
+	if errors.Is(err, repo.ErrTimerNotRunning) {
+		return common_error.NewError(common_error.TIMER_NOT_RUNNING, err)
+	}
+	if errors.Is(err, repo.ErrInvalidMove) {
+		return common_error.NewError(common_error.DATA_INVALID, err)
+	}
+	log.Println(err)
+	return err
+}
+
+// publishExpired mengirimkan event task expired ke NATS.
+// Status task sudah tersimpan, sehingga kegagalan publish hanya dicatat.
+func (uc *taskUseCase) publishExpired(event *dto.TaskExpiredEventDTO) {
+	newData, _ := json.Marshal(event)
+	if err := uc.Publisher.Nats(newData, Const.TASK_EXPIRED); err != nil {
+		log.Println(err)
+	}
+}
@@ -3,19 +3,35 @@ package task
 import (
 	"encoding/json"
 	"errors"
+	"io"
+	"strings"
 	"time"
 	mockPubliser "todo_list/mock/infra/broker/nats/publisher"
+	mockBlob "todo_list/mock/infra/persistence/blob"
+	mockExpiry "todo_list/mock/infra/persistence/redis"
 	mockRepo "todo_list/mock/repositories/task"
 
 	"testing"
+	shareDto "todo_list/src/app/dto/share"
 	dto "todo_list/src/app/dto/task"
+	repo "todo_list/src/app/repositories/task"
 
 	Const "todo_list/src/infra/constants"
+	common_error "todo_list/src/infra/errors"
+	"todo_list/src/infra/helper"
+	"todo_list/src/infra/persistence/blob"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
+// taskOwner adalah pemilik task pada data uji
+var taskOwner = dto.TaskOwnerDTO{ID: 1}
+
+// attachmentLimits adalah batas lampiran pada data uji
+var attachmentLimits = blob.Limits{MaxFileSize: 10, UserQuota: 100}
+
 type MockUserUseCase struct {
 	mock.Mock
 }
@@ -26,16 +42,21 @@ type UserUseCaseList struct {
 	useCase        TaskUCInterface
 	mockRepo       *mockRepo.MockTask
 	mockPubliser   *mockPubliser.MockPublisher
+	mockExpiry     *mockExpiry.MockTaskExpiry
+	mockBlob       *mockBlob.MockBlobStore
 	dtoAddTask     *dto.CreateTaskReqDTO
 	dtoFinishTask  *dto.FinishtTaskReqDTO
 	dtoGetTaskList *dto.GetTaskReqDTO
+	dtoExpireTask  *dto.ExpireTaskReqDTO
 }
 
 func (suite *UserUseCaseList) SetupTest() {
 
 	suite.mockRepo = new(mockRepo.MockTask)
 	suite.mockPubliser = new(mockPubliser.MockPublisher)
-	suite.useCase = NewTaskUseCase(suite.mockPubliser, suite.mockRepo)
+	suite.mockExpiry = new(mockExpiry.MockTaskExpiry)
+	suite.mockBlob = new(mockBlob.MockBlobStore)
+	suite.useCase = NewTaskUseCase(suite.mockPubliser, suite.mockRepo, suite.mockExpiry, suite.mockBlob, attachmentLimits)
 
 	expiresAt, _ := time.Parse(time.RFC3339, "2025-03-16T12:41:00Z")
 
@@ -46,13 +67,18 @@ func (suite *UserUseCaseList) SetupTest() {
 	}
 
 	suite.dtoFinishTask = &dto.FinishtTaskReqDTO{
-		ID: 1,
+		ID:     1,
+		UserID: 1,
 	}
 
 	suite.dtoGetTaskList = &dto.GetTaskReqDTO{
 		UserID: 1,
 	}
 
+	suite.dtoExpireTask = &dto.ExpireTaskReqDTO{
+		ID: 1,
+	}
+
 }
 
 func (u *UserUseCaseList) TestAddTaskSuccess() {
@@ -70,6 +96,7 @@ func (u *UserUseCaseList) TestAddTaskFail() {
 }
 
 func (u *UserUseCaseList) TestFinistTaskSuccess() {
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: "pending"}, nil)
 	newData, _ := json.Marshal(u.dtoFinishTask)
 	u.mockPubliser.Mock.On("Nats", newData, Const.FINISH_TASK).Return(nil)
 	err := u.useCase.FinishTask(u.dtoFinishTask)
@@ -77,24 +104,1413 @@ func (u *UserUseCaseList) TestFinistTaskSuccess() {
 }
 
 func (u *UserUseCaseList) TestFinistTaskFail() {
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: "pending"}, nil)
 	newData, _ := json.Marshal(u.dtoFinishTask)
 	u.mockPubliser.Mock.On("Nats", newData, Const.FINISH_TASK).Return(errors.New(mock.Anything))
 	err := u.useCase.FinishTask(u.dtoFinishTask)
 	u.Equal(errors.New(mock.Anything), err)
 }
 
+func (u *UserUseCaseList) TestFinistTaskOtherUser() {
+	req := &dto.FinishtTaskReqDTO{ID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(nil, repo.ErrTaskNotFound)
+	err := u.useCase.FinishTask(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.FINISH_TASK)
+}
+
+func (u *UserUseCaseList) TestFinishTaskBlocked() {
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: "pending", BlockedBy: dto.TaskIDs{2}}, nil)
+	err := u.useCase.FinishTask(u.dtoFinishTask)
+	u.Equal(common_error.TASK_BLOCKED, err.(*common_error.CommonError).ErrorCode)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.FINISH_TASK)
+}
+
+func (u *UserUseCaseList) TestSaveFinishTaskOtherUser() {
+	req := &dto.FinishtTaskReqDTO{ID: 1, UserID: 2}
+	u.mockRepo.Mock.On("FinishTask", req).Return(nil, repo.ErrTaskNotFound)
+	err := u.useCase.SaveFinishTask(req)
+	u.Equal(repo.ErrTaskNotFound, err)
+	u.mockExpiry.AssertNotCalled(u.T(), "Cancel", int64(1))
+}
+
 func (u *UserUseCaseList) TestGetTaskListSuccess() {
-	u.mockRepo.Mock.On("GetTaskList", u.dtoGetTaskList).Return(mock.Anything, nil)
+	u.mockRepo.Mock.On("GetTaskList", u.dtoGetTaskList).Return(mock.Anything, int64(0), nil)
 	_, err := u.useCase.GetTaskList(u.dtoGetTaskList)
 	u.Equal(nil, err)
 }
 
+func (u *UserUseCaseList) TestGetTaskListDefaultPage() {
+	u.mockRepo.Mock.On("GetTaskList", u.dtoGetTaskList).Return([]*dto.GetTaskRespDTO{}, int64(25), nil)
+	resp, err := u.useCase.GetTaskList(u.dtoGetTaskList)
+	u.Equal(nil, err)
+	u.Equal(int64(25), resp.Total)
+	u.Equal("", resp.NextCursor)
+	u.Equal(helper.Page, u.dtoGetTaskList.Page)
+	u.Equal(helper.PerPage, u.dtoGetTaskList.PerPage)
+}
+
+func (u *UserUseCaseList) TestGetTaskListNextCursor() {
+	req := &dto.GetTaskReqDTO{UserID: 1, PerPage: 2}
+	tasks := []*dto.GetTaskRespDTO{
+		{ID: 1, ExpiresAt: u.dtoAddTask.ExpiresAt},
+		{ID: 2, ExpiresAt: u.dtoAddTask.ExpiresAt.Add(time.Hour)},
+	}
+	u.mockRepo.Mock.On("GetTaskList", req).Return(tasks, int64(5), nil)
+	resp, err := u.useCase.GetTaskList(req)
+	u.Equal(nil, err)
+	u.Equal(helper.EncodeCursor(tasks[1].ExpiresAt, 2), resp.NextCursor)
+}
+
+func (u *UserUseCaseList) TestGetTaskListNormalizesTags() {
+	req := &dto.GetTaskReqDTO{UserID: 1, Tags: []string{"Work", "work", " urgent"}, TagMode: "all"}
+	u.mockRepo.Mock.On("GetTaskList", req).Return([]*dto.GetTaskRespDTO{}, int64(0), nil)
+	_, err := u.useCase.GetTaskList(req)
+	u.Equal(nil, err)
+	u.Equal([]string{"work", "urgent"}, req.Tags)
+}
+
+func (u *UserUseCaseList) TestGetTaskListPriorityFilter() {
+	req := &dto.GetTaskReqDTO{UserID: 1, Priority: []string{"high", "urgent"}}
+	u.mockRepo.Mock.On("GetTaskList", req).Return([]*dto.GetTaskRespDTO{{ID: 1, Priority: "high"}}, int64(1), nil)
+	resp, err := u.useCase.GetTaskList(req)
+	u.Equal(nil, err)
+	u.Equal(int64(1), resp.Total)
+	u.Equal([]string{"high", "urgent"}, req.Priority) // Filter diteruskan ke repository tanpa diubah
+	u.mockRepo.AssertCalled(u.T(), "GetTaskList", req)
+}
+
 func (u *UserUseCaseList) TestGetTaskListFail() {
-	u.mockRepo.Mock.On("GetTaskList", u.dtoGetTaskList).Return(nil, errors.New(mock.Anything))
+	u.mockRepo.Mock.On("GetTaskList", u.dtoGetTaskList).Return(nil, int64(0), errors.New(mock.Anything))
 	_, err := u.useCase.GetTaskList(u.dtoGetTaskList)
 	u.Equal(errors.New(mock.Anything), err)
 }
 
+func (u *UserUseCaseList) TestSaveTaskSuccess() {
+	u.mockRepo.Mock.On("AddTask", u.dtoAddTask).Return(int64(1), nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), u.dtoAddTask.ExpiresAt).Return(nil)
+	err := u.useCase.SaveTask(u.dtoAddTask)
+	u.Equal(nil, err)
+	u.mockExpiry.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestSaveTaskScheduleFail() {
+	u.mockRepo.Mock.On("AddTask", u.dtoAddTask).Return(int64(1), nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), u.dtoAddTask.ExpiresAt).Return(errors.New(mock.Anything))
+	err := u.useCase.SaveTask(u.dtoAddTask)
+	u.Equal(nil, err)
+}
+
+func (u *UserUseCaseList) TestSaveTaskFail() {
+	u.mockRepo.Mock.On("AddTask", u.dtoAddTask).Return(int64(0), errors.New(mock.Anything))
+	err := u.useCase.SaveTask(u.dtoAddTask)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestSaveTaskRecurringStoresUTC() {
+	// Seri dibuat dari klien WIB, dtstart dan expires_at harus tersimpan sebagai UTC
+	expiresAt := time.Date(2025, 3, 3, 9, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	req := &dto.CreateTaskReqDTO{
+		UserID:     1,
+		Title:      "weekly",
+		Recurrence: "FREQ=WEEKLY;BYDAY=MO",
+		Timezone:   "Asia/Jakarta",
+		ExpiresAt:  expiresAt,
+	}
+	var stored time.Time
+	u.mockRepo.Mock.On("AddTask", req).Run(func(args mock.Arguments) {
+		stored = args.Get(0).(*dto.CreateTaskReqDTO).ExpiresAt
+	}).Return(int64(1), nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), expiresAt.UTC()).Return(nil)
+
+	err := u.useCase.SaveTask(req)
+
+	u.Equal(nil, err)
+	u.Equal(time.UTC, stored.Location())
+	u.Equal(2, stored.Hour())
+	u.mockExpiry.AssertCalled(u.T(), "Schedule", int64(1), expiresAt.UTC())
+}
+
+func (u *UserUseCaseList) TestSaveFinishTaskSuccess() {
+	u.mockRepo.Mock.On("FinishTask", u.dtoFinishTask).Return(&dto.TaskTransitionEventDTO{TaskID: 1, UserID: 1, Action: dto.TaskEventFinish, From: dto.StatusPending, To: dto.StatusDone}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockExpiry.Mock.On("Cancel", u.dtoFinishTask.ID).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", u.dtoFinishTask.ID).Return(nil, nil)
+	err := u.useCase.SaveFinishTask(u.dtoFinishTask)
+	u.Equal(nil, err)
+	u.mockExpiry.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestSaveFinishTaskBlockedAfterPublish() {
+	// Ketergantungan ditambahkan setelah event finish dikirim
+	u.mockRepo.Mock.On("FinishTask", u.dtoFinishTask).Return(nil, repo.ErrTaskBlocked)
+	err := u.useCase.SaveFinishTask(u.dtoFinishTask)
+	u.Equal(common_error.TASK_BLOCKED, err.(*common_error.CommonError).ErrorCode)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.TASK_TRANSITION)
+	u.mockExpiry.AssertNotCalled(u.T(), "Cancel", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestSaveFinishTaskFail() {
+	u.mockRepo.Mock.On("FinishTask", u.dtoFinishTask).Return(nil, errors.New(mock.Anything))
+	err := u.useCase.SaveFinishTask(u.dtoFinishTask)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestExpireTaskSuccess() {
+	event := &dto.TaskExpiredEventDTO{ID: 1, UserID: 1}
+	newData, _ := json.Marshal(event)
+	u.mockRepo.Mock.On("ExpireTask", u.dtoExpireTask).Return(event, nil)
+	u.mockPubliser.Mock.On("Nats", newData, Const.TASK_EXPIRED).Return(nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", int64(1)).Return(nil, nil)
+	err := u.useCase.ExpireTask(u.dtoExpireTask)
+	u.Equal(nil, err)
+	u.mockPubliser.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestExpireTaskAlreadyFinished() {
+	u.mockRepo.Mock.On("ExpireTask", u.dtoExpireTask).Return(nil, nil)
+	err := u.useCase.ExpireTask(u.dtoExpireTask)
+	u.Equal(nil, err)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.TASK_EXPIRED)
+}
+
+func (u *UserUseCaseList) TestExpireTaskFail() {
+	u.mockRepo.Mock.On("ExpireTask", u.dtoExpireTask).Return(nil, errors.New(mock.Anything))
+	err := u.useCase.ExpireTask(u.dtoExpireTask)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestExpireOverdueTasksSuccess() {
+	events := []*dto.TaskExpiredEventDTO{{ID: 1, UserID: 1}, {ID: 2, UserID: 1}}
+	u.mockRepo.Mock.On("ExpireOverdueTasks", 100).Return(events, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_EXPIRED).Return(nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", mock.AnythingOfType("int64")).Return(nil, nil)
+	total, err := u.useCase.ExpireOverdueTasks(100)
+	u.Equal(nil, err)
+	u.Equal(2, total)
+	u.mockPubliser.AssertNumberOfCalls(u.T(), "Nats", 4) // taskexpired dan tasktransition untuk setiap task
+}
+
+func (u *UserUseCaseList) TestExpireOverdueTasksFail() {
+	u.mockRepo.Mock.On("ExpireOverdueTasks", 100).Return(nil, errors.New(mock.Anything))
+	_, err := u.useCase.ExpireOverdueTasks(100)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestUpdateTaskSuccess() {
+	expiresAt := u.dtoAddTask.ExpiresAt.Add(time.Hour)
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, ExpiresAt: &expiresAt}
+	resp := &dto.GetTaskRespDTO{ID: 1, Status: "pending", ExpiresAt: expiresAt}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Return(resp, nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), expiresAt).Return(nil)
+	data, err := u.useCase.UpdateTask(req)
+	u.Equal(nil, err)
+	u.Equal(resp, data)
+	u.mockExpiry.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestUpdateTaskExpiresAtOffset() {
+	// expires_at dari klien WIB disimpan dan dijadwalkan sebagai UTC
+	expiresAt := time.Date(2030, 1, 2, 9, 0, 0, 0, time.FixedZone("WIB", 7*60*60))
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, ExpiresAt: &expiresAt}
+	resp := &dto.GetTaskRespDTO{ID: 1, Status: "pending", ExpiresAt: expiresAt.UTC()}
+	var stored time.Time
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Run(func(args mock.Arguments) {
+		stored = *args.Get(0).(*dto.UpdateTaskReqDTO).ExpiresAt
+	}).Return(resp, nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), expiresAt.UTC()).Return(nil)
+
+	_, err := u.useCase.UpdateTask(req)
+
+	u.Equal(nil, err)
+	u.Equal(time.UTC, stored.Location())
+	u.Equal(2, stored.Hour())
+	u.mockExpiry.AssertCalled(u.T(), "Schedule", int64(1), expiresAt.UTC())
+}
+
+func (u *UserUseCaseList) TestUpdateTaskTitleOnly() {
+	title := "new title"
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, Title: &title}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Return(&dto.GetTaskRespDTO{ID: 1, Status: "pending"}, nil)
+	_, err := u.useCase.UpdateTask(req)
+	u.Equal(nil, err)
+	u.mockExpiry.AssertNotCalled(u.T(), "Schedule", mock.Anything, mock.Anything)
+}
+
+func (u *UserUseCaseList) TestUpdateTaskDetails() {
+	description, priority, notes := "agenda **rapat**", "urgent", "bawa laptop"
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, Description: &description, Priority: &priority, Notes: &notes}
+	resp := &dto.GetTaskRespDTO{ID: 1, Status: "pending", Description: description, Priority: priority, Notes: notes}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Return(resp, nil)
+	data, err := u.useCase.UpdateTask(req)
+	u.Equal(nil, err)
+	u.Equal("urgent", data.Priority)
+	u.Equal(description, data.Description)
+	u.mockExpiry.AssertNotCalled(u.T(), "Schedule", mock.Anything, mock.Anything)
+}
+
+func (u *UserUseCaseList) TestUpdateTaskTags() {
+	tags := []string{"Home", "home"}
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, Tags: &tags}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Return(&dto.GetTaskRespDTO{ID: 1, Status: "pending"}, nil)
+	_, err := u.useCase.UpdateTask(req)
+	u.Equal(nil, err)
+	u.Equal([]string{"home"}, *req.Tags)
+}
+
+func (u *UserUseCaseList) TestUpdateTaskOtherUser() {
+	title := "new title"
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 2, Title: &title}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(nil, repo.ErrTaskNotFound)
+	_, err := u.useCase.UpdateTask(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestUpdateTaskFail() {
+	title := "new title"
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, Title: &title}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Return(nil, errors.New(mock.Anything))
+	_, err := u.useCase.UpdateTask(req)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestDeleteTaskSuccess() {
+	req := &dto.DeleteTaskReqDTO{ID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("DeleteTask", req).Return(nil)
+	u.mockExpiry.Mock.On("Cancel", int64(1)).Return(nil)
+	err := u.useCase.DeleteTask(req)
+	u.Equal(nil, err)
+	u.mockExpiry.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestDeleteTaskOtherUser() {
+	req := &dto.DeleteTaskReqDTO{ID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(nil, repo.ErrTaskNotFound)
+	err := u.useCase.DeleteTask(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestRestoreTaskSuccess() {
+	req := &dto.RestoreTaskReqDTO{ID: 1, UserID: 1}
+	resp := &dto.GetTaskRespDTO{ID: 1, Status: "pending", ExpiresAt: u.dtoAddTask.ExpiresAt}
+	u.mockRepo.Mock.On("RestoreTask", req).Return(resp, nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), resp.ExpiresAt).Return(nil)
+	data, err := u.useCase.RestoreTask(req)
+	u.Equal(nil, err)
+	u.Equal(resp, data)
+	u.mockExpiry.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestRestoreTaskNotInTrash() {
+	req := &dto.RestoreTaskReqDTO{ID: 1, UserID: 1}
+	u.mockRepo.Mock.On("RestoreTask", req).Return(nil, repo.ErrTaskNotFound)
+	_, err := u.useCase.RestoreTask(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestGetTrashListSuccess() {
+	u.mockRepo.Mock.On("GetTrashList", u.dtoGetTaskList).Return([]*dto.GetTaskRespDTO{}, nil)
+	_, err := u.useCase.GetTrashList(u.dtoGetTaskList)
+	u.Equal(nil, err)
+}
+
+func (u *UserUseCaseList) TestGetTrashListFail() {
+	u.mockRepo.Mock.On("GetTrashList", u.dtoGetTaskList).Return(nil, errors.New(mock.Anything))
+	_, err := u.useCase.GetTrashList(u.dtoGetTaskList)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestPurgeTrashSuccess() {
+	u.mockRepo.Mock.On("PurgeTrash", mock.AnythingOfType("time.Time")).Return([]int64{1, 2}, nil)
+	u.mockBlob.Mock.On("DeletePrefix", "tasks/1").Return(nil)
+	u.mockBlob.Mock.On("DeletePrefix", "tasks/2").Return(errors.New(mock.Anything))
+	total, err := u.useCase.PurgeTrash(24 * time.Hour)
+	u.Equal(nil, err)
+	u.Equal(2, total)
+	u.mockBlob.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestPurgeTrashFail() {
+	u.mockRepo.Mock.On("PurgeTrash", mock.AnythingOfType("time.Time")).Return(nil, errors.New(mock.Anything))
+	_, err := u.useCase.PurgeTrash(24 * time.Hour)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestSearchTaskSuccess() {
+	req := &dto.SearchTaskReqDTO{UserID: 1, Query: "rapat"}
+	resp := []*dto.SearchTaskRespDTO{{ID: 1, Title: "rapat mingguan", Snippet: "<mark>rapat</mark> mingguan"}}
+	u.mockRepo.Mock.On("SearchTask", req).Return(resp, int64(1), nil)
+	data, total, err := u.useCase.SearchTask(req)
+	u.Equal(nil, err)
+	u.Equal(resp, data)
+	u.Equal(int64(1), total)
+	u.Equal(helper.PerPage, req.PerPage)
+}
+
+func (u *UserUseCaseList) TestSearchTaskFail() {
+	req := &dto.SearchTaskReqDTO{UserID: 1, Query: "rapat"}
+	u.mockRepo.Mock.On("SearchTask", req).Return(nil, int64(0), errors.New(mock.Anything))
+	_, _, err := u.useCase.SearchTask(req)
+	u.Equal(errors.New(mock.Anything), err)
+}
+
+func (u *UserUseCaseList) TestAddChecklistItemOtherUser() {
+	req := &dto.AddChecklistItemReqDTO{TaskID: 1, UserID: 2, Title: "beli kopi"}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(nil, repo.ErrTaskNotFound)
+	_, err := u.useCase.AddChecklistItem(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "AddChecklistItem", req)
+}
+
+func (u *UserUseCaseList) TestCheckChecklistItemAutoFinish() {
+	req := &dto.CheckChecklistItemReqDTO{ID: 3, TaskID: 1, UserID: 1, Done: true}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: "pending", AutoFinish: true}, nil)
+	u.mockRepo.Mock.On("CheckChecklistItem", req).Return(&dto.ChecklistSummaryDTO{Total: 2, Done: 2}, nil)
+	newData, _ := json.Marshal(u.dtoFinishTask)
+	u.mockPubliser.Mock.On("Nats", newData, Const.FINISH_TASK).Return(nil)
+	_, err := u.useCase.CheckChecklistItem(req)
+	u.Equal(nil, err)
+	u.mockPubliser.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestCheckChecklistItemWithoutAutoFinish() {
+	req := &dto.CheckChecklistItemReqDTO{ID: 3, TaskID: 1, UserID: 1, Done: true}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: "pending"}, nil)
+	u.mockRepo.Mock.On("CheckChecklistItem", req).Return(&dto.ChecklistSummaryDTO{Total: 2, Done: 2}, nil)
+	_, err := u.useCase.CheckChecklistItem(req)
+	u.Equal(nil, err)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.FINISH_TASK)
+}
+
+func (u *UserUseCaseList) TestCheckChecklistItemAutoFinishBlocked() {
+	req := &dto.CheckChecklistItemReqDTO{ID: 3, TaskID: 1, UserID: 1, Done: true}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: "pending", AutoFinish: true, BlockedBy: dto.TaskIDs{2}}, nil)
+	u.mockRepo.Mock.On("CheckChecklistItem", req).Return(&dto.ChecklistSummaryDTO{Total: 2, Done: 2}, nil)
+	_, err := u.useCase.CheckChecklistItem(req)
+	u.Equal(nil, err)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.FINISH_TASK)
+}
+
+func (u *UserUseCaseList) TestCheckChecklistItemNotFound() {
+	req := &dto.CheckChecklistItemReqDTO{ID: 3, TaskID: 1, UserID: 1, Done: true}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: "pending"}, nil)
+	u.mockRepo.Mock.On("CheckChecklistItem", req).Return(nil, repo.ErrItemNotFound)
+	_, err := u.useCase.CheckChecklistItem(req)
+	u.Equal(common_error.ITEM_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestReorderChecklistSuccess() {
+	req := &dto.ReorderChecklistReqDTO{TaskID: 1, UserID: 1, ItemIDs: []int64{3, 2}}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("ReorderChecklist", req).Return(nil)
+	err := u.useCase.ReorderChecklist(req)
+	u.Equal(nil, err)
+}
+
+func (u *UserUseCaseList) TestReorderChecklistIncomplete() {
+	req := &dto.ReorderChecklistReqDTO{TaskID: 1, UserID: 1, ItemIDs: []int64{3}}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("ReorderChecklist", req).Return(repo.ErrIncompleteChecklist)
+	err := u.useCase.ReorderChecklist(req)
+	u.Equal(common_error.DATA_INVALID, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestAddTaskOtherUserProject() {
+	projectID := int64(7)
+	req := &dto.CreateTaskReqDTO{UserID: 1, Title: "test", ProjectID: &projectID, ExpiresAt: u.dtoAddTask.ExpiresAt}
+	u.mockRepo.Mock.On("ProjectExists", int64(7), int64(1)).Return(repo.ErrProjectNotFound)
+	err := u.useCase.AddTask(req)
+	u.Equal(common_error.PROJECT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.ADD_TASK)
+}
+
+func (u *UserUseCaseList) TestUpdateTaskMoveProject() {
+	projectID := int64(7)
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, ProjectID: &projectID}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("ProjectExists", int64(7), int64(1)).Return(nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Return(&dto.GetTaskRespDTO{ID: 1, Status: "done", ProjectID: &projectID}, nil)
+	data, err := u.useCase.UpdateTask(req)
+	u.Equal(nil, err)
+	u.Equal(&projectID, data.ProjectID)
+}
+
+func (u *UserUseCaseList) TestUpdateTaskRemoveProject() {
+	projectID := int64(0)
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 1, ProjectID: &projectID}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateTask", req).Return(&dto.GetTaskRespDTO{ID: 1, Status: "done"}, nil)
+	_, err := u.useCase.UpdateTask(req)
+	u.Equal(nil, err)
+	u.mockRepo.AssertNotCalled(u.T(), "ProjectExists", mock.Anything, mock.Anything)
+}
+
+func (u *UserUseCaseList) TestGetTaskListOtherUserProject() {
+	projectID := int64(7)
+	req := &dto.GetTaskReqDTO{UserID: 1, ProjectID: &projectID}
+	u.mockRepo.Mock.On("ProjectVisible", int64(7), int64(1)).Return(repo.ErrProjectNotFound)
+	_, err := u.useCase.GetTaskList(req)
+	u.Equal(common_error.PROJECT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestSaveFinishTaskSpawnsNextInstance() {
+	lastTaskID := u.dtoFinishTask.ID
+	rec := &dto.TaskRecurrenceDTO{
+		ID:            3,
+		RRule:         "FREQ=WEEKLY;BYDAY=MO",
+		Timezone:      "UTC",
+		DTStart:       time.Now().Add(-7 * 24 * time.Hour),
+		Active:        true,
+		LastTaskID:    &lastTaskID,
+		TaskExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+	u.mockRepo.Mock.On("FinishTask", u.dtoFinishTask).Return(&dto.TaskTransitionEventDTO{TaskID: 1, UserID: 1, Action: dto.TaskEventFinish, From: dto.StatusPending, To: dto.StatusDone}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockExpiry.Mock.On("Cancel", u.dtoFinishTask.ID).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", u.dtoFinishTask.ID).Return(rec, nil)
+	u.mockRepo.Mock.On("AddNextInstance", mock.MatchedBy(func(req *dto.NextInstanceReqDTO) bool {
+		return req.RecurrenceID == 3 && req.PrevTaskID == 1 && req.ExpiresAt.Weekday() == time.Monday &&
+			req.ExpiresAt.After(rec.TaskExpiresAt)
+	})).Return(int64(9), nil)
+	u.mockExpiry.Mock.On("Schedule", int64(9), mock.AnythingOfType("time.Time")).Return(nil)
+	err := u.useCase.SaveFinishTask(u.dtoFinishTask)
+	u.Equal(nil, err)
+	u.mockRepo.AssertExpectations(u.T())
+	u.mockExpiry.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestSaveFinishTaskSpawnsNextInstanceInUTC() {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		u.T().Skip("time zone database not available")
+	}
+
+	// Seri setiap Senin 09:00 WIB, instance berikutnya disimpan sebagai 02:00 UTC
+	lastTaskID := u.dtoFinishTask.ID
+	dtstart := time.Date(2025, 3, 3, 9, 0, 0, 0, loc).UTC()
+	rec := &dto.TaskRecurrenceDTO{
+		ID:            3,
+		RRule:         "FREQ=WEEKLY;BYDAY=MO",
+		Timezone:      "Asia/Jakarta",
+		DTStart:       dtstart,
+		Active:        true,
+		LastTaskID:    &lastTaskID,
+		TaskExpiresAt: time.Now().Add(24 * time.Hour),
+	}
+	var stored time.Time
+	u.mockRepo.Mock.On("FinishTask", u.dtoFinishTask).Return(&dto.TaskTransitionEventDTO{TaskID: 1, UserID: 1, Action: dto.TaskEventFinish, From: dto.StatusPending, To: dto.StatusDone}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockExpiry.Mock.On("Cancel", u.dtoFinishTask.ID).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", u.dtoFinishTask.ID).Return(rec, nil)
+	u.mockRepo.Mock.On("AddNextInstance", mock.AnythingOfType("*task.NextInstanceReqDTO")).Run(func(args mock.Arguments) {
+		stored = args.Get(0).(*dto.NextInstanceReqDTO).ExpiresAt
+	}).Return(int64(9), nil)
+	u.mockExpiry.Mock.On("Schedule", int64(9), mock.AnythingOfType("time.Time")).Return(nil)
+
+	err = u.useCase.SaveFinishTask(u.dtoFinishTask)
+
+	u.Equal(nil, err)
+	u.Equal(time.UTC, stored.Location())
+	u.Equal(2, stored.Hour())
+	u.Equal(time.Monday, stored.Weekday())
+	u.Equal(9, stored.In(loc).Hour())
+}
+
+func (u *UserUseCaseList) TestExpireTaskStoppedSeries() {
+	lastTaskID := int64(1)
+	event := &dto.TaskExpiredEventDTO{ID: 1, UserID: 1}
+	rec := &dto.TaskRecurrenceDTO{ID: 3, RRule: "FREQ=DAILY", Timezone: "UTC", Active: false, LastTaskID: &lastTaskID}
+	u.mockRepo.Mock.On("ExpireTask", u.dtoExpireTask).Return(event, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_EXPIRED).Return(nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", int64(1)).Return(rec, nil)
+	err := u.useCase.ExpireTask(u.dtoExpireTask)
+	u.Equal(nil, err)
+	u.mockRepo.AssertNotCalled(u.T(), "AddNextInstance", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestUpdateRecurrenceNotRecurring() {
+	recurrence := "FREQ=DAILY"
+	req := &dto.UpdateRecurrenceReqDTO{TaskID: 1, UserID: 1, Recurrence: &recurrence}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateRecurrence", req).Return(nil, repo.ErrRecurrenceNotFound)
+	_, err := u.useCase.UpdateRecurrence(req)
+	u.Equal(common_error.RECURRENCE_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestStopRecurrenceSuccess() {
+	req := &dto.StopRecurrenceReqDTO{TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("StopRecurrence", req).Return(nil)
+	err := u.useCase.StopRecurrence(req)
+	u.Equal(nil, err)
+}
+
+func (u *UserUseCaseList) TestSendDueRemindersSuccess() {
+	events := []*dto.TaskReminderEventDTO{{ID: 1, TaskID: 1, UserID: 1}, {ID: 2, TaskID: 2, UserID: 1}}
+	u.mockRepo.Mock.On("ClaimDueReminders", 100).Return(events, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_REMINDER).Return(nil)
+	u.mockRepo.Mock.On("MarkReminderSent", mock.AnythingOfType("int64")).Return(nil)
+	total, err := u.useCase.SendDueReminders(100)
+	u.Equal(nil, err)
+	u.Equal(2, total)
+	u.mockPubliser.AssertNumberOfCalls(u.T(), "Nats", 2)
+	u.mockRepo.AssertCalled(u.T(), "MarkReminderSent", int64(1))
+	u.mockRepo.AssertCalled(u.T(), "MarkReminderSent", int64(2))
+	u.mockRepo.AssertNotCalled(u.T(), "ReleaseReminder", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestSendDueRemindersRedeliversStaleClaim() {
+	event := &dto.TaskReminderEventDTO{ID: 1, TaskID: 1, UserID: 1}
+
+	// Putaran pertama: publish berhasil tetapi penandaan terkirim gagal, seperti proses yang mati setelah publish
+	u.mockRepo.Mock.On("ClaimDueReminders", 100).Return([]*dto.TaskReminderEventDTO{event}, nil).Once()
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_REMINDER).Return(nil)
+	u.mockRepo.Mock.On("MarkReminderSent", int64(1)).Return(errors.New(mock.Anything)).Once()
+	total, err := u.useCase.SendDueReminders(100)
+	u.Equal(nil, err)
+	u.Equal(1, total)
+	u.mockRepo.AssertNotCalled(u.T(), "ReleaseReminder", mock.Anything)
+
+	// Putaran berikutnya setelah lease habis: klaim yang sama diambil lagi dan kali ini ditandai terkirim
+	u.mockRepo.Mock.On("ClaimDueReminders", 100).Return([]*dto.TaskReminderEventDTO{event}, nil).Once()
+	u.mockRepo.Mock.On("MarkReminderSent", int64(1)).Return(nil).Once()
+	total, err = u.useCase.SendDueReminders(100)
+	u.Equal(nil, err)
+	u.Equal(1, total)
+	u.mockPubliser.AssertNumberOfCalls(u.T(), "Nats", 2)
+	u.mockRepo.AssertNumberOfCalls(u.T(), "MarkReminderSent", 2)
+}
+
+func (u *UserUseCaseList) TestSendDueRemindersPublishFail() {
+	events := []*dto.TaskReminderEventDTO{{ID: 1, TaskID: 1, UserID: 1}, {ID: 2, TaskID: 2, UserID: 1}}
+	u.mockRepo.Mock.On("ClaimDueReminders", 100).Return(events, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_REMINDER).Return(errors.New(mock.Anything))
+	u.mockRepo.Mock.On("ReleaseReminder", mock.AnythingOfType("int64")).Return(nil)
+	total, err := u.useCase.SendDueReminders(100)
+	u.Equal(errors.New(mock.Anything), err)
+	u.Equal(0, total)
+	u.mockRepo.AssertCalled(u.T(), "ReleaseReminder", int64(1))
+	u.mockRepo.AssertCalled(u.T(), "ReleaseReminder", int64(2))
+	u.mockRepo.AssertNotCalled(u.T(), "MarkReminderSent", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestSendDueRemindersFail() {
+	u.mockRepo.Mock.On("ClaimDueReminders", 100).Return(nil, errors.New(mock.Anything))
+	_, err := u.useCase.SendDueReminders(100)
+	u.Equal(errors.New(mock.Anything), err)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.TASK_REMINDER)
+}
+
+func (u *UserUseCaseList) TestAddDependencySuccess() {
+	req := &dto.AddDependencyReqDTO{TaskID: 1, UserID: 1, DependsOnID: 2}
+	u.mockRepo.Mock.On("AddDependency", req).Return(nil)
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, BlockedBy: dto.TaskIDs{2}}, nil)
+	resp, err := u.useCase.AddDependency(req)
+	u.Equal(nil, err)
+	u.Equal(dto.TaskIDs{2}, resp.BlockedBy)
+}
+
+func (u *UserUseCaseList) TestAddDependencyCycle() {
+	req := &dto.AddDependencyReqDTO{TaskID: 1, UserID: 1, DependsOnID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("AddDependency", req).Return(repo.ErrDependencyCycle)
+	_, err := u.useCase.AddDependency(req)
+	u.Equal(common_error.DEPENDENCY_CYCLE, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestAddDependencyOtherUserTask() {
+	req := &dto.AddDependencyReqDTO{TaskID: 1, UserID: 1, DependsOnID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("AddDependency", req).Return(repo.ErrTaskNotFound)
+	_, err := u.useCase.AddDependency(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestRemoveDependencyNotFound() {
+	req := &dto.RemoveDependencyReqDTO{TaskID: 1, UserID: 1, DependsOnID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("RemoveDependency", req).Return(repo.ErrDependencyNotFound)
+	_, err := u.useCase.RemoveDependency(req)
+	u.Equal(common_error.DEPENDENCY_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestUpdateSharedTaskAsEditor() {
+	title := "new title"
+	req := &dto.UpdateTaskReqDTO{ID: 1, UserID: 2, Title: &title}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor}, nil)
+	u.mockRepo.Mock.On("UpdateTask", mock.Anything).Return(&dto.GetTaskRespDTO{ID: 1, Status: "pending", Owner: taskOwner}, nil)
+	data, err := u.useCase.UpdateTask(req)
+	u.Equal(nil, err)
+	u.Equal(int64(1), req.UserID) // Perubahan disimpan atas nama pemilik task
+	u.Equal(shareDto.PermissionEditor, data.Permission)
+}
+
+func (u *UserUseCaseList) TestFinishSharedTaskAsViewer() {
+	req := &dto.FinishtTaskReqDTO{ID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	err := u.useCase.FinishTask(req)
+	u.Equal(common_error.PERMISSION_DENIED, err.(*common_error.CommonError).ErrorCode)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.FINISH_TASK)
+}
+
+func (u *UserUseCaseList) TestDeleteSharedTaskAsEditor() {
+	req := &dto.DeleteTaskReqDTO{ID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor}, nil)
+	err := u.useCase.DeleteTask(req)
+	u.Equal(common_error.PERMISSION_DENIED, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "DeleteTask", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestGetTaskListSharedProject() {
+	projectID := int64(7)
+	req := &dto.GetTaskReqDTO{UserID: 2, ProjectID: &projectID}
+	u.mockRepo.Mock.On("ProjectVisible", int64(7), int64(2)).Return(nil)
+	u.mockRepo.Mock.On("GetTaskList", req).Return([]*dto.GetTaskRespDTO{{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer}}, int64(1), nil)
+	resp, err := u.useCase.GetTaskList(req)
+	u.Equal(nil, err)
+	u.Equal(taskOwner, resp.Tasks[0].Owner)
+}
+
+func (u *UserUseCaseList) TestShareTaskSuccess() {
+	req := &shareDto.ShareReqDTO{ID: 1, UserID: 1, Email: "friend@mail.com", Permission: shareDto.PermissionEditor}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("ShareTask", req).Return(&shareDto.ShareRespDTO{UserID: 2, Email: "friend@mail.com", Permission: shareDto.PermissionEditor}, nil)
+	resp, err := u.useCase.ShareTask(req)
+	u.Equal(nil, err)
+	u.Equal(int64(2), resp.UserID)
+}
+
+func (u *UserUseCaseList) TestShareTaskNotOwner() {
+	req := &shareDto.ShareReqDTO{ID: 1, UserID: 2, Email: "other@mail.com", Permission: shareDto.PermissionViewer}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor}, nil)
+	_, err := u.useCase.ShareTask(req)
+	u.Equal(common_error.PERMISSION_DENIED, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "ShareTask", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestShareTaskUnknownEmail() {
+	req := &shareDto.ShareReqDTO{ID: 1, UserID: 1, Email: "nobody@mail.com", Permission: shareDto.PermissionViewer}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("ShareTask", req).Return(nil, repo.ErrUserNotFound)
+	_, err := u.useCase.ShareTask(req)
+	u.Equal(common_error.USER_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestRemoveTaskShareNotFound() {
+	req := &shareDto.RemoveShareReqDTO{ID: 1, UserID: 1, ShareUserID: 3}
+	u.mockRepo.Mock.On("RemoveTaskShare", req).Return(repo.ErrShareNotFound)
+	err := u.useCase.RemoveTaskShare(req)
+	u.Equal(common_error.SHARE_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestAddCommentPublishesEvent() {
+	req := &dto.AddCommentReqDTO{TaskID: 1, UserID: 2, Body: "sudah saya cek"}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Title: "test", Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	comment := &dto.CommentRespDTO{ID: 5, TaskID: 1, Body: req.Body, Author: dto.CommentAuthorDTO{ID: 2, Name: "budi"}}
+	u.mockRepo.Mock.On("AddComment", req).Return(comment, nil)
+	newData, _ := json.Marshal(&dto.TaskCommentedEventDTO{
+		ID: 5, TaskID: 1, TaskTitle: "test", TaskOwnerID: 1, AuthorID: 2, AuthorName: "budi", Body: req.Body,
+	})
+	u.mockPubliser.Mock.On("Nats", newData, Const.TASK_COMMENTED).Return(nil)
+	resp, err := u.useCase.AddComment(req)
+	u.Equal(nil, err)
+	u.Equal(comment, resp)
+	u.mockPubliser.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestAddCommentPublishFail() {
+	req := &dto.AddCommentReqDTO{TaskID: 1, UserID: 1, Body: "catatan"}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("AddComment", req).Return(&dto.CommentRespDTO{ID: 5, TaskID: 1}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_COMMENTED).Return(errors.New(mock.Anything))
+	_, err := u.useCase.AddComment(req)
+	u.Equal(nil, err)
+}
+
+func (u *UserUseCaseList) TestAddCommentNoAccess() {
+	req := &dto.AddCommentReqDTO{TaskID: 1, UserID: 2, Body: "halo"}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(nil, repo.ErrTaskNotFound)
+	_, err := u.useCase.AddComment(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "AddComment", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestGetCommentListDefaultPagination() {
+	req := &dto.GetCommentListReqDTO{TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetCommentList", req).Return([]*dto.CommentRespDTO{{ID: 5}}, int64(1), nil)
+	resp, total, err := u.useCase.GetCommentList(req)
+	u.Equal(nil, err)
+	u.Equal(int64(1), total)
+	u.Len(resp, 1)
+	u.Equal(helper.Page, req.Page)
+	u.Equal(helper.PerPage, req.PerPage)
+}
+
+func (u *UserUseCaseList) TestUpdateCommentNotOwn() {
+	req := &dto.UpdateCommentReqDTO{ID: 5, TaskID: 1, UserID: 1, Body: "ubah"}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("UpdateComment", req).Return(nil, repo.ErrCommentNotFound)
+	_, err := u.useCase.UpdateComment(req)
+	u.Equal(common_error.COMMENT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestDeleteCommentSuccess() {
+	req := &dto.DeleteCommentReqDTO{ID: 5, TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("DeleteComment", req).Return(nil)
+	err := u.useCase.DeleteComment(req)
+	u.Equal(nil, err)
+}
+
+// attachmentKeyOf mencocokkan key lampiran milik task
+func attachmentKeyOf(taskID string) interface{} {
+	return mock.MatchedBy(func(key string) bool { return strings.HasPrefix(key, "tasks/"+taskID+"/") })
+}
+
+func (u *UserUseCaseList) TestAddAttachmentSuccess() {
+	req := &dto.AddAttachmentReqDTO{TaskID: 1, UserID: 1, FileName: "a.png", ContentType: "image/png", File: strings.NewReader("12345")}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetAttachmentUsage", int64(1)).Return(int64(40), nil)
+	u.mockBlob.Mock.On("Put", attachmentKeyOf("1"), mock.Anything).Return(int64(5), nil)
+	attachment := &dto.AttachmentRespDTO{ID: 3, TaskID: 1, FileName: "a.png", Size: 5}
+	u.mockRepo.Mock.On("AddAttachment", mock.MatchedBy(func(r *dto.AddAttachmentReqDTO) bool {
+		return r.Size == 5 && strings.HasPrefix(r.StorageKey, "tasks/1/")
+	}), int64(100)).Return(attachment, nil)
+	resp, err := u.useCase.AddAttachment(req)
+	u.Equal(nil, err)
+	u.Equal(attachment, resp)
+	u.mockBlob.AssertNotCalled(u.T(), "Delete", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestAddAttachmentTooLarge() {
+	req := &dto.AddAttachmentReqDTO{TaskID: 1, UserID: 1, FileName: "a.pdf", ContentType: "application/pdf", File: strings.NewReader("0123456789a")}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetAttachmentUsage", int64(1)).Return(int64(0), nil)
+	u.mockBlob.Mock.On("Put", attachmentKeyOf("1"), mock.Anything).Return(int64(11), nil)
+	u.mockBlob.Mock.On("Delete", attachmentKeyOf("1")).Return(nil)
+	_, err := u.useCase.AddAttachment(req)
+	u.Equal(common_error.ATTACHMENT_TOO_LARGE, err.(*common_error.CommonError).ErrorCode)
+	u.mockBlob.AssertExpectations(u.T())
+	u.mockRepo.AssertNotCalled(u.T(), "AddAttachment", mock.Anything, mock.Anything)
+}
+
+func (u *UserUseCaseList) TestAddAttachmentExceedsRemainingQuota() {
+	req := &dto.AddAttachmentReqDTO{TaskID: 1, UserID: 1, FileName: "a.pdf", ContentType: "application/pdf", File: strings.NewReader("12345")}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetAttachmentUsage", int64(1)).Return(int64(96), nil)
+	u.mockBlob.Mock.On("Put", attachmentKeyOf("1"), mock.Anything).Return(int64(5), nil)
+	u.mockBlob.Mock.On("Delete", attachmentKeyOf("1")).Return(nil)
+	_, err := u.useCase.AddAttachment(req)
+	u.Equal(common_error.QUOTA_EXCEEDED, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestAddAttachmentQuotaUsedUp() {
+	req := &dto.AddAttachmentReqDTO{TaskID: 1, UserID: 1, FileName: "a.pdf", ContentType: "application/pdf", File: strings.NewReader("1")}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetAttachmentUsage", int64(1)).Return(int64(100), nil)
+	_, err := u.useCase.AddAttachment(req)
+	u.Equal(common_error.QUOTA_EXCEEDED, err.(*common_error.CommonError).ErrorCode)
+	u.mockBlob.AssertNotCalled(u.T(), "Put", mock.Anything, mock.Anything)
+}
+
+func (u *UserUseCaseList) TestAddAttachmentConcurrentQuota() {
+	req := &dto.AddAttachmentReqDTO{TaskID: 1, UserID: 1, FileName: "a.pdf", ContentType: "application/pdf", File: strings.NewReader("12345")}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetAttachmentUsage", int64(1)).Return(int64(0), nil)
+	u.mockBlob.Mock.On("Put", attachmentKeyOf("1"), mock.Anything).Return(int64(5), nil)
+	u.mockRepo.Mock.On("AddAttachment", mock.Anything, int64(100)).Return(nil, repo.ErrQuotaExceeded)
+	u.mockBlob.Mock.On("Delete", attachmentKeyOf("1")).Return(nil)
+	_, err := u.useCase.AddAttachment(req)
+	u.Equal(common_error.QUOTA_EXCEEDED, err.(*common_error.CommonError).ErrorCode)
+	u.mockBlob.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestAddAttachmentViewer() {
+	req := &dto.AddAttachmentReqDTO{TaskID: 1, UserID: 2, FileName: "a.pdf", ContentType: "application/pdf", File: strings.NewReader("1")}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	_, err := u.useCase.AddAttachment(req)
+	u.Equal(common_error.PERMISSION_DENIED, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestGetAttachmentSuccess() {
+	req := &dto.GetAttachmentReqDTO{ID: 3, TaskID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	attachment := &dto.AttachmentRespDTO{ID: 3, TaskID: 1, StorageKey: "tasks/1/abc"}
+	u.mockRepo.Mock.On("GetAttachment", req).Return(attachment, nil)
+	u.mockBlob.Mock.On("Open", "tasks/1/abc").Return(io.NopCloser(strings.NewReader("isi")), nil)
+	resp, file, err := u.useCase.GetAttachment(req)
+	u.Equal(nil, err)
+	u.Equal(attachment, resp)
+	data, _ := io.ReadAll(file)
+	u.Equal("isi", string(data))
+}
+
+func (u *UserUseCaseList) TestGetAttachmentMissingBlob() {
+	req := &dto.GetAttachmentReqDTO{ID: 3, TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetAttachment", req).Return(&dto.AttachmentRespDTO{ID: 3, StorageKey: "tasks/1/abc"}, nil)
+	u.mockBlob.Mock.On("Open", "tasks/1/abc").Return(nil, blob.ErrBlobNotFound)
+	_, _, err := u.useCase.GetAttachment(req)
+	u.Equal(common_error.ATTACHMENT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestDeleteAttachmentRemovesBlob() {
+	req := &dto.DeleteAttachmentReqDTO{ID: 3, TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("DeleteAttachment", req).Return(&dto.AttachmentRespDTO{ID: 3, StorageKey: "tasks/1/abc"}, nil)
+	u.mockBlob.Mock.On("Delete", "tasks/1/abc").Return(nil)
+	err := u.useCase.DeleteAttachment(req)
+	u.Equal(nil, err)
+	u.mockBlob.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestDeleteAttachmentNotFound() {
+	req := &dto.DeleteAttachmentReqDTO{ID: 3, TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("DeleteAttachment", req).Return(nil, repo.ErrAttachmentNotFound)
+	err := u.useCase.DeleteAttachment(req)
+	u.Equal(common_error.ATTACHMENT_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestCheckChecklistItemAutoFinishKeepsAudit() {
+	audit := dto.AuditDTO{ActorID: 2, RequestID: "req-1"}
+	req := &dto.CheckChecklistItemReqDTO{ID: 3, TaskID: 1, UserID: 2, Done: true, Audit: audit}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor, Status: "pending", AutoFinish: true}, nil)
+	u.mockRepo.Mock.On("CheckChecklistItem", req).Return(&dto.ChecklistSummaryDTO{Total: 1, Done: 1}, nil)
+	newData, _ := json.Marshal(&dto.FinishtTaskReqDTO{ID: 1, UserID: 1, Audit: audit})
+	u.mockPubliser.Mock.On("Nats", newData, Const.FINISH_TASK).Return(nil)
+	_, err := u.useCase.CheckChecklistItem(req)
+	u.Equal(nil, err)
+	u.mockPubliser.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestGetTaskHistoryDefaultPagination() {
+	req := &dto.GetTaskHistoryReqDTO{TaskID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	events := []*dto.TaskEventRespDTO{{ID: 2, TaskID: 1, Action: dto.TaskEventFinish}, {ID: 1, TaskID: 1, Action: dto.TaskEventCreate}}
+	u.mockRepo.Mock.On("GetTaskHistory", req).Return(events, int64(2), nil)
+	resp, total, err := u.useCase.GetTaskHistory(req)
+	u.Equal(nil, err)
+	u.Equal(events, resp)
+	u.Equal(int64(2), total)
+	u.Equal(helper.Page, req.Page)
+	u.Equal(helper.PerPage, req.PerPage)
+}
+
+func (u *UserUseCaseList) TestGetTaskHistoryNoAccess() {
+	req := &dto.GetTaskHistoryReqDTO{TaskID: 1, UserID: 3}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 3}).Return(nil, repo.ErrTaskNotFound)
+	_, _, err := u.useCase.GetTaskHistory(req)
+	u.Equal(common_error.TASK_NOT_FOUND, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "GetTaskHistory", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestStartTaskSuccess() {
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 2, Action: dto.TaskEventStart}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor, Status: dto.StatusPending}, nil)
+	u.mockRepo.Mock.On("TransitionTask", req).Return(&dto.TaskTransitionEventDTO{TaskID: 1, UserID: 1, Action: dto.TaskEventStart, From: dto.StatusPending, To: dto.StatusInProgress}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(nil, err)
+	u.Equal(int64(1), req.UserID) // Status disimpan atas nama pemilik task
+	u.Equal([]string{dto.StatusPending}, req.From)
+	u.Equal(dto.StatusInProgress, req.To)
+	u.mockPubliser.AssertExpectations(u.T())
+}
+
+func (u *UserUseCaseList) TestCancelTaskSpawnsNextInstance() {
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventCancel}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusInProgress}, nil)
+	u.mockRepo.Mock.On("TransitionTask", req).Return(&dto.TaskTransitionEventDTO{TaskID: 1, UserID: 1, Action: dto.TaskEventCancel, From: dto.StatusInProgress, To: dto.StatusCancelled}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockExpiry.Mock.On("Cancel", int64(1)).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", int64(1)).Return(nil, nil)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(nil, err)
+	u.mockExpiry.AssertCalled(u.T(), "Cancel", int64(1))
+	u.mockRepo.AssertCalled(u.T(), "GetTaskRecurrence", int64(1))
+}
+
+func (u *UserUseCaseList) TestReopenTaskSchedulesExpiry() {
+	expiresAt := time.Now().Add(time.Hour)
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventReopen}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusDone, ExpiresAt: expiresAt}, nil)
+	u.mockRepo.Mock.On("TransitionTask", req).Return(&dto.TaskTransitionEventDTO{TaskID: 1, UserID: 1, Action: dto.TaskEventReopen, From: dto.StatusDone, To: dto.StatusPending}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), expiresAt).Return(nil)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(nil, err)
+	u.mockExpiry.AssertCalled(u.T(), "Schedule", int64(1), expiresAt)
+}
+
+func (u *UserUseCaseList) TestReopenOverdueTaskRequiresExpiresAt() {
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventReopen}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusDone, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(common_error.INVALID_TRANSITION, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "TransitionTask", mock.Anything)
+	u.mockExpiry.AssertNotCalled(u.T(), "Schedule", mock.Anything, mock.Anything)
+}
+
+func (u *UserUseCaseList) TestReopenOverdueTaskWithNewExpiresAt() {
+	newExpiresAt := time.Now().Add(24 * time.Hour)
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventReopen, ExpiresAt: &newExpiresAt}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusDone, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+	u.mockRepo.Mock.On("TransitionTask", req).Return(&dto.TaskTransitionEventDTO{TaskID: 1, UserID: 1, Action: dto.TaskEventReopen, From: dto.StatusDone, To: dto.StatusPending}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), newExpiresAt.UTC()).Return(nil)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(nil, err)
+	u.Equal(newExpiresAt.UTC(), *req.ExpiresAt)
+	u.mockExpiry.AssertCalled(u.T(), "Schedule", int64(1), newExpiresAt.UTC())
+	u.mockPubliser.AssertNumberOfCalls(u.T(), "Nats", 1)
+}
+
+func (u *UserUseCaseList) TestReopenWithPastExpiresAt() {
+	past := time.Now().Add(-time.Minute)
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventReopen, ExpiresAt: &past}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusDone, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(common_error.DATA_INVALID, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "TransitionTask", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestTransitionTaskNotAllowed() {
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventStart}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusCancelled}, nil)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(common_error.INVALID_TRANSITION, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "TransitionTask", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestTransitionTaskRaceLost() {
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventStart}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusPending}, nil)
+	u.mockRepo.Mock.On("TransitionTask", req).Return(nil, repo.ErrInvalidTransition)
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(common_error.INVALID_TRANSITION, err.(*common_error.CommonError).ErrorCode)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.TASK_TRANSITION)
+}
+
+func (u *UserUseCaseList) TestTransitionTaskUnknownAction() {
+	req := &dto.TransitionTaskReqDTO{ID: 1, UserID: 1, Action: dto.TaskEventExpire}
+	_, err := u.useCase.TransitionTask(req)
+	u.Equal(common_error.DATA_INVALID, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "GetTask", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestFinishDoneTask() {
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusDone}, nil)
+	err := u.useCase.FinishTask(u.dtoFinishTask)
+	u.Equal(common_error.INVALID_TRANSITION, err.(*common_error.CommonError).ErrorCode)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.FINISH_TASK)
+}
+
+func (u *UserUseCaseList) TestBulkTaskBestEffort() {
+	priority := "high"
+	req := &dto.BulkTaskReqDTO{UserID: 2, Mode: dto.BulkModeBestEffort, Operations: []*dto.BulkOperationDTO{
+		{Op: dto.BulkFinish, ID: 1},
+		{Op: dto.BulkSetPriority, ID: 3, Priority: &priority},
+	}}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer, Status: dto.StatusPending}, nil)
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 3, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 3, Owner: taskOwner, Permission: shareDto.PermissionEditor, Status: dto.StatusPending}, nil)
+	u.mockRepo.Mock.On("BulkTask", mock.Anything).Return([]*dto.BulkTaskResultDTO{
+		{Index: 1, ID: 3, Op: dto.BulkSetPriority, Status: dto.BulkStatusOK, Task: &dto.GetTaskRespDTO{ID: 3, Priority: priority}},
+	}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_BULK).Return(nil)
+
+	resp, err := u.useCase.BulkTask(req)
+
+	u.Equal(nil, err)
+	u.True(resp.Committed)
+	u.Equal(1, resp.Succeeded)
+	u.Equal(1, resp.Failed)
+	u.Equal(uint(common_error.PERMISSION_DENIED), resp.Results[0].Code)
+	u.Equal(dto.BulkStatusOK, resp.Results[1].Status)
+
+	// Hanya operasi yang lolos pengecekan yang dijalankan, atas nama pemilik task
+	bulkReq := u.mockRepo.Calls[len(u.mockRepo.Calls)-1].Arguments.Get(0).(*dto.BulkTaskReqDTO)
+	u.Equal([]*dto.BulkOperationDTO{req.Operations[1]}, bulkReq.Operations)
+	u.Equal(int64(1), req.Operations[1].UserID)
+	u.mockPubliser.AssertNumberOfCalls(u.T(), "Nats", 1)
+}
+
+func (u *UserUseCaseList) TestBulkTaskNullOperation() {
+	req := &dto.BulkTaskReqDTO{UserID: 1, Operations: []*dto.BulkOperationDTO{{Op: dto.BulkFinish, ID: 1}, nil}}
+	_, err := u.useCase.BulkTask(req)
+	u.Equal(common_error.DATA_INVALID, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "GetTask", mock.Anything)
+	u.mockRepo.AssertNotCalled(u.T(), "BulkTask", mock.Anything)
+}
+
+func (u *UserUseCaseList) TestBulkTaskAtomicPrecheckFailed() {
+	req := &dto.BulkTaskReqDTO{UserID: 1, Operations: []*dto.BulkOperationDTO{
+		{Op: dto.BulkDelete, ID: 1},
+		{Op: dto.BulkFinish, ID: 2},
+	}}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusPending}, nil)
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 2, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 2, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusDone}, nil)
+
+	resp, err := u.useCase.BulkTask(req)
+
+	u.Equal(nil, err)
+	u.Equal(dto.BulkModeAtomic, resp.Mode)
+	u.False(resp.Committed)
+	u.Equal(dto.BulkStatusSkipped, resp.Results[0].Status)
+	u.Equal(uint(common_error.INVALID_TRANSITION), resp.Results[1].Code)
+	u.mockRepo.AssertNotCalled(u.T(), "BulkTask", mock.Anything)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.TASK_BULK)
+}
+
+func (u *UserUseCaseList) TestBulkTaskAtomicAborted() {
+	req := &dto.BulkTaskReqDTO{UserID: 1, Mode: dto.BulkModeAtomic, Operations: []*dto.BulkOperationDTO{
+		{Op: dto.BulkDelete, ID: 1},
+		{Op: dto.BulkRestore, ID: 2},
+	}}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusPending}, nil)
+	u.mockRepo.Mock.On("BulkTask", mock.Anything).Return([]*dto.BulkTaskResultDTO{
+		{Index: 0, ID: 1, Op: dto.BulkDelete, Status: dto.BulkStatusRolledBack},
+		{Index: 1, ID: 2, Op: dto.BulkRestore, Status: dto.BulkStatusFailed, Err: repo.ErrTaskNotFound},
+	}, repo.ErrBulkAborted)
+
+	resp, err := u.useCase.BulkTask(req)
+
+	u.Equal(nil, err)
+	u.False(resp.Committed)
+	u.Equal(0, resp.Succeeded)
+	u.Equal(uint(common_error.TASK_NOT_FOUND), resp.Results[1].Code)
+	u.mockExpiry.AssertNotCalled(u.T(), "Cancel", int64(1))
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.TASK_BULK)
+}
+
+func (u *UserUseCaseList) TestBulkTaskFinishPublishesOneEvent() {
+	req := &dto.BulkTaskReqDTO{UserID: 1, Mode: dto.BulkModeAtomic, Operations: []*dto.BulkOperationDTO{
+		{Op: dto.BulkFinish, ID: 1},
+		{Op: dto.BulkFinish, ID: 2},
+	}}
+	for _, id := range []int64{1, 2} {
+		u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: id, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: id, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusInProgress}, nil)
+		u.mockExpiry.Mock.On("Cancel", id).Return(nil)
+		u.mockRepo.Mock.On("GetTaskRecurrence", id).Return(nil, nil)
+	}
+	u.mockRepo.Mock.On("BulkTask", mock.Anything).Return([]*dto.BulkTaskResultDTO{
+		{Index: 0, ID: 1, Op: dto.BulkFinish, Status: dto.BulkStatusOK, Transition: &dto.TaskTransitionEventDTO{TaskID: 1, From: dto.StatusInProgress, To: dto.StatusDone}},
+		{Index: 1, ID: 2, Op: dto.BulkFinish, Status: dto.BulkStatusOK, Transition: &dto.TaskTransitionEventDTO{TaskID: 2, From: dto.StatusInProgress, To: dto.StatusDone}},
+	}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_BULK).Return(nil)
+	var published []int64
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Run(func(args mock.Arguments) {
+		var event dto.TaskTransitionEventDTO
+		json.Unmarshal(args.Get(0).([]byte), &event)
+		published = append(published, event.TaskID)
+	}).Return(nil)
+
+	resp, err := u.useCase.BulkTask(req)
+
+	u.Equal(nil, err)
+	u.Equal(2, resp.Succeeded)
+	u.Equal(transitions[dto.TaskEventFinish].from, req.Operations[0].From)
+	u.mockExpiry.AssertNumberOfCalls(u.T(), "Cancel", 2)
+	u.mockPubliser.AssertNumberOfCalls(u.T(), "Nats", 3)
+	u.Equal([]int64{1, 2}, published)
+}
+
+func (u *UserUseCaseList) TestBulkTaskBestEffortPublishesCommittedTransitions() {
+	req := &dto.BulkTaskReqDTO{UserID: 1, Mode: dto.BulkModeBestEffort, Operations: []*dto.BulkOperationDTO{
+		{Op: dto.BulkFinish, ID: 1},
+		{Op: dto.BulkFinish, ID: 2},
+	}}
+	for _, id := range []int64{1, 2} {
+		u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: id, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: id, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusPending}, nil)
+	}
+	u.mockExpiry.Mock.On("Cancel", int64(1)).Return(nil)
+	u.mockRepo.Mock.On("GetTaskRecurrence", int64(1)).Return(nil, nil)
+	// Savepoint operasi kedua di-rollback, sehingga hanya task 1 yang berpindah status
+	u.mockRepo.Mock.On("BulkTask", mock.Anything).Return([]*dto.BulkTaskResultDTO{
+		{Index: 0, ID: 1, Op: dto.BulkFinish, Status: dto.BulkStatusOK, Transition: &dto.TaskTransitionEventDTO{TaskID: 1, From: dto.StatusPending, To: dto.StatusDone}},
+		{Index: 1, ID: 2, Op: dto.BulkFinish, Status: dto.BulkStatusFailed, Err: repo.ErrInvalidTransition},
+	}, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_BULK).Return(nil)
+	var published []int64
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Run(func(args mock.Arguments) {
+		var event dto.TaskTransitionEventDTO
+		json.Unmarshal(args.Get(0).([]byte), &event)
+		published = append(published, event.TaskID)
+	}).Return(nil)
+
+	resp, err := u.useCase.BulkTask(req)
+
+	u.Equal(nil, err)
+	u.Equal(1, resp.Succeeded)
+	u.Equal([]int64{1}, published)
+}
+
+func (u *UserUseCaseList) TestStartTimerSuccess() {
+	req := &dto.TimerReqDTO{TaskID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor}, nil)
+	u.mockRepo.Mock.On("StartTimer", req).Return(&dto.TimeEntryRespDTO{ID: 5, TaskID: 1, UserID: 2}, nil)
+	resp, err := u.useCase.StartTimer(req)
+	u.Equal(nil, err)
+	u.Equal(int64(2), resp.UserID) // Waktu dicatat atas nama user yang meminta
+}
+
+func (u *UserUseCaseList) TestStartTimerAlreadyRunning() {
+	req := &dto.TimerReqDTO{TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("StartTimer", req).Return(nil, repo.ErrTimerRunning)
+	_, err := u.useCase.StartTimer(req)
+	u.Equal(common_error.TIMER_RUNNING, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestStartTimerAsViewer() {
+	req := &dto.TimerReqDTO{TaskID: 1, UserID: 2}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	_, err := u.useCase.StartTimer(req)
+	u.Equal(common_error.PERMISSION_DENIED, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "StartTimer", req)
+}
+
+func (u *UserUseCaseList) TestStopTimerNotRunning() {
+	req := &dto.TimerReqDTO{TaskID: 1, UserID: 1}
+	u.mockRepo.Mock.On("StopTimer", req).Return(nil, repo.ErrTimerNotRunning)
+	_, err := u.useCase.StopTimer(req)
+	u.Equal(common_error.TIMER_NOT_RUNNING, err.(*common_error.CommonError).ErrorCode)
+}
+
+func (u *UserUseCaseList) TestGetTimeReportFillsEmptyDays() {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	req := &dto.GetTimeReportReqDTO{UserID: 1, From: from, To: from.AddDate(0, 0, 2)}
+	u.mockRepo.Mock.On("GetTimeReport", req).Return([]*dto.TimeReportRowDTO{
+		{Day: from, TaskID: 1, Title: "a", Seconds: 600},
+		{Day: from, TaskID: 2, Title: "b", Seconds: 300},
+		{Day: from.AddDate(0, 0, 2), TaskID: 1, Title: "a", Seconds: 60},
+	}, nil)
+
+	resp, err := u.useCase.GetTimeReport(req)
+
+	u.Equal(nil, err)
+	u.Equal(int64(960), resp.TotalSeconds)
+	u.Len(resp.Days, 3)
+	u.Equal("2025-03-01", resp.Days[0].Date)
+	u.Equal(int64(900), resp.Days[0].Seconds)
+	u.Len(resp.Days[0].Tasks, 2)
+	u.Equal(int64(0), resp.Days[1].Seconds)
+	u.Empty(resp.Days[1].Tasks)
+	u.Equal(int64(60), resp.Days[2].Seconds)
+}
+
+func (u *UserUseCaseList) TestSnoozeRevivesExpiredTask() {
+	req := &dto.SnoozeTaskReqDTO{ID: 1, UserID: 2, Preset: dto.SnoozeOneHour}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor, Status: dto.StatusExpired, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+	resp := &dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Status: dto.StatusPending, ExpiresAt: time.Now().Add(time.Hour), SnoozeCount: 1}
+	u.mockRepo.Mock.On("SnoozeTask", req).Return(resp, dto.StatusExpired, nil)
+	u.mockPubliser.Mock.On("Nats", mock.Anything, Const.TASK_TRANSITION).Return(nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), resp.ExpiresAt).Return(nil)
+
+	data, err := u.useCase.SnoozeTask(req)
+
+	u.Equal(nil, err)
+	u.Equal(dto.StatusPending, data.Status)
+	u.Equal(shareDto.PermissionEditor, data.Permission)
+	u.Equal(int64(1), req.UserID) // Snooze disimpan atas nama pemilik task
+	u.Equal(snoozable, req.From)
+	u.mockPubliser.AssertNumberOfCalls(u.T(), "Nats", 1)
+	u.mockExpiry.AssertCalled(u.T(), "Schedule", int64(1), resp.ExpiresAt)
+}
+
+func (u *UserUseCaseList) TestSnoozePendingTaskWithoutTransition() {
+	until := time.Now().Add(48 * time.Hour)
+	req := &dto.SnoozeTaskReqDTO{ID: 1, UserID: 1, Until: &until}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusPending, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	u.mockRepo.Mock.On("SnoozeTask", req).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Status: dto.StatusPending, ExpiresAt: until}, dto.StatusPending, nil)
+	u.mockExpiry.Mock.On("Schedule", int64(1), until).Return(nil)
+
+	_, err := u.useCase.SnoozeTask(req)
+
+	u.Equal(nil, err)
+	u.Equal(until.UTC(), req.ExpiresAt)
+	u.mockPubliser.AssertNotCalled(u.T(), "Nats", mock.Anything, Const.TASK_TRANSITION)
+}
+
+func (u *UserUseCaseList) TestSnoozeMustMoveExpiryLater() {
+	req := &dto.SnoozeTaskReqDTO{ID: 1, UserID: 1, Preset: dto.SnoozeOneHour}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusPending, ExpiresAt: time.Now().Add(72 * time.Hour)}, nil)
+	_, err := u.useCase.SnoozeTask(req)
+	u.Equal(common_error.DATA_INVALID, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "SnoozeTask", req)
+}
+
+func (u *UserUseCaseList) TestSnoozeDoneTask() {
+	req := &dto.SnoozeTaskReqDTO{ID: 1, UserID: 1, Preset: dto.SnoozeTomorrow}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner, Status: dto.StatusDone}, nil)
+	_, err := u.useCase.SnoozeTask(req)
+	u.Equal(common_error.INVALID_TRANSITION, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "SnoozeTask", req)
+}
+
+func (u *UserUseCaseList) TestMoveSharedTask() {
+	after, before := int64(2), int64(3)
+	req := &dto.MoveTaskReqDTO{ID: 1, UserID: 2, After: &after, Before: &before}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionEditor}, nil)
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 2, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 2, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 3, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 3, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+	position := "V"
+	u.mockRepo.Mock.On("MoveTask", req).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Position: &position}, nil)
+
+	data, err := u.useCase.MoveTask(req)
+
+	u.Equal(nil, err)
+	u.Equal("V", *data.Position)
+	u.Equal(shareDto.PermissionEditor, data.Permission)
+	u.Equal(int64(1), req.UserID) // Urutan disimpan pada daftar pemilik task
+}
+
+func (u *UserUseCaseList) TestMoveTaskNeighbourOtherOwner() {
+	after := int64(2)
+	req := &dto.MoveTaskReqDTO{ID: 1, UserID: 1, After: &after}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 2, UserID: 1}).Return(&dto.GetTaskRespDTO{ID: 2, Owner: dto.TaskOwnerDTO{ID: 5}, Permission: shareDto.PermissionViewer}, nil)
+
+	_, err := u.useCase.MoveTask(req)
+
+	u.Equal(common_error.DATA_INVALID, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "MoveTask", req)
+}
+
+func (u *UserUseCaseList) TestMoveTaskRequiresEditor() {
+	before := int64(2)
+	req := &dto.MoveTaskReqDTO{ID: 1, UserID: 2, Before: &before}
+	u.mockRepo.Mock.On("GetTask", &dto.GetTaskByIDReqDTO{ID: 1, UserID: 2}).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionViewer}, nil)
+
+	_, err := u.useCase.MoveTask(req)
+
+	u.Equal(common_error.PERMISSION_DENIED, err.(*common_error.CommonError).ErrorCode)
+	u.mockRepo.AssertNotCalled(u.T(), "MoveTask", req)
+}
+
+func (u *UserUseCaseList) TestMoveTaskInvalidOrder() {
+	after, before := int64(3), int64(2)
+	req := &dto.MoveTaskReqDTO{ID: 1, UserID: 1, After: &after, Before: &before}
+	u.mockRepo.Mock.On("GetTask", mock.Anything).Return(&dto.GetTaskRespDTO{ID: 1, Owner: taskOwner, Permission: shareDto.PermissionOwner}, nil)
+	u.mockRepo.Mock.On("MoveTask", req).Return(nil, repo.ErrInvalidMove)
+
+	_, err := u.useCase.MoveTask(req)
+
+	u.Equal(common_error.DATA_INVALID, err.(*common_error.CommonError).ErrorCode)
+}
+
+func TestSnoozeUntilPresets(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// Rabu pukul 23:30 WIB
+	now := time.Date(2025, 3, 5, 23, 30, 0, 0, loc)
+
+	cases := []struct {
+		preset string
+		want   time.Time
+	}{
+		{dto.SnoozeOneHour, now.Add(time.Hour)},
+		{dto.SnoozeTomorrow, time.Date(2025, 3, 6, 9, 0, 0, 0, loc)},
+		{dto.SnoozeNextWeek, time.Date(2025, 3, 10, 9, 0, 0, 0, loc)},
+	}
+
+	for _, c := range cases {
+		got := snoozeUntil(&dto.SnoozeTaskReqDTO{Preset: c.preset, Timezone: "Asia/Jakarta"}, now)
+		assert.True(t, c.want.Equal(got), c.preset)
+		assert.Equal(t, time.UTC, got.Location(), c.preset)
+	}
+}
+
+func TestSnoozeUntilNextWeekFromMonday(t *testing.T) {
+	now := time.Date(2025, 3, 3, 8, 0, 0, 0, time.UTC) // Senin
+	got := snoozeUntil(&dto.SnoozeTaskReqDTO{Preset: dto.SnoozeNextWeek}, now)
+	assert.Equal(t, time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC), got)
+}
+
+func TestCanTransition(t *testing.T) {
+	cases := []struct {
+		status string
+		action string
+		want   bool
+	}{
+		{dto.StatusPending, dto.TaskEventStart, true},
+		{dto.StatusInProgress, dto.TaskEventStart, false},
+		{dto.StatusPending, dto.TaskEventFinish, true},
+		{dto.StatusInProgress, dto.TaskEventFinish, true},
+		{dto.StatusDone, dto.TaskEventFinish, false},
+		{dto.StatusInProgress, dto.TaskEventCancel, true},
+		{dto.StatusExpired, dto.TaskEventCancel, false},
+		{dto.StatusDone, dto.TaskEventReopen, true},
+		{dto.StatusCancelled, dto.TaskEventReopen, false},
+		{dto.StatusInProgress, dto.TaskEventExpire, true},
+		{dto.StatusCancelled, dto.TaskEventExpire, false},
+		{dto.StatusPending, "unknown", false},
+	}
+
+	for _, c := range cases {
+		assert.Equal(t, c.want, canTransition(c.status, c.action), c.status+" "+c.action)
+	}
+}
+
+func TestNextOccurrenceUsesTimezone(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// Senin pukul 09:00 WIB adalah Senin pukul 02:00 UTC
+	dtstart := time.Date(2025, 3, 3, 9, 0, 0, 0, loc).UTC()
+	rec := &dto.TaskRecurrenceDTO{RRule: "FREQ=WEEKLY;BYDAY=MO", Timezone: "Asia/Jakarta", DTStart: dtstart, TaskExpiresAt: dtstart}
+
+	next, ok, err := nextOccurrence(rec, dtstart.Add(-time.Hour))
+
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.True(t, time.Date(2025, 3, 10, 9, 0, 0, 0, loc).Equal(next))
+	assert.Equal(t, time.Date(2025, 3, 10, 2, 0, 0, 0, time.UTC), next) // Disimpan ke kolom TIMESTAMP dalam UTC
+}
+
+func TestNextOccurrenceUntilInSeriesTimezone(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// UNTIL tanpa Z adalah 10 Maret 08:00 WIB, sebelum kejadian 09:00 WIB pada hari itu
+	dtstart := time.Date(2025, 3, 3, 9, 0, 0, 0, loc).UTC()
+	rec := &dto.TaskRecurrenceDTO{RRule: "FREQ=WEEKLY;BYDAY=MO;UNTIL=20250310T080000", Timezone: "Asia/Jakarta", DTStart: dtstart, TaskExpiresAt: dtstart}
+
+	_, ok, err := nextOccurrence(rec, dtstart.Add(time.Minute))
+
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestNextOccurrenceAfterLateFinish(t *testing.T) {
+	dtstart := time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)
+	rec := &dto.TaskRecurrenceDTO{RRule: "FREQ=WEEKLY;BYDAY=MO", Timezone: "UTC", DTStart: dtstart, TaskExpiresAt: dtstart}
+
+	next, ok, err := nextOccurrence(rec, time.Date(2025, 3, 20, 0, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2025, 3, 24, 9, 0, 0, 0, time.UTC), next)
+}
+
 func TestUsecase(t *testing.T) {
 	suite.Run(t, new(UserUseCaseList))
 }
@@ -0,0 +1,62 @@
+package task
+
+import (
+	shareDto "todo_list/src/app/dto/share"
+	dto "todo_list/src/app/dto/task"
+)
+
+// StartTimer memulai timer user pada task yang bisa diubah user.
+// Waktu dicatat atas nama user yang meminta, bukan pemilik task.
+func (uc *taskUseCase) StartTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error) {
+	if _, err := uc.getTask(req.TaskID, req.UserID, shareDto.PermissionEditor); err != nil {
+		return nil, err
+	}
+
+	resp, err := uc.Repo.StartTimer(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return resp, nil
+}
+
+// StopTimer menghentikan timer user pada task. Hak akses tidak dicek ulang
+// agar timer tetap bisa dihentikan setelah task dihapus atau tidak lagi dibagikan.
+func (uc *taskUseCase) StopTimer(req *dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error) {
+	resp, err := uc.Repo.StopTimer(req)
+	if err != nil {
+		return nil, taskError(err)
+	}
+	return resp, nil
+}
+
+// GetTimeReport mengambil laporan waktu user per hari, hari tanpa catatan waktu tetap ditampilkan
+func (uc *taskUseCase) GetTimeReport(req *dto.GetTimeReportReqDTO) (*dto.TimeReportRespDTO, error) {
+	rows, err := uc.Repo.GetTimeReport(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &dto.TimeReportRespDTO{
+		From: req.From.Format(dto.DateLayout),
+		To:   req.To.Format(dto.DateLayout),
+		Days: []*dto.TimeReportDayDTO{},
+	}
+
+	days := map[string]*dto.TimeReportDayDTO{}
+	for d := req.From; !d.After(req.To); d = d.AddDate(0, 0, 1) {
+		day := &dto.TimeReportDayDTO{Date: d.Format(dto.DateLayout), Tasks: []*dto.TimeReportTaskDTO{}}
+		resp.Days = append(resp.Days, day)
+		days[day.Date] = day
+	}
+
+	for _, row := range rows {
+		day, ok := days[row.Day.Format(dto.DateLayout)]
+		if !ok {
+			continue
+		}
+		day.Seconds += row.Seconds
+		day.Tasks = append(day.Tasks, &dto.TimeReportTaskDTO{TaskID: row.TaskID, Title: row.Title, Seconds: row.Seconds})
+		resp.TotalSeconds += row.Seconds
+	}
+	return resp, nil
+}
@@ -1,11 +1,15 @@
 package usecases
 
 import (
+	projectUC "todo_list/src/app/usecases/project"
+	tagUC "todo_list/src/app/usecases/tag"
 	taskUC "todo_list/src/app/usecases/task"
 	userUC "todo_list/src/app/usecases/user"
 )
 
 type AllUseCases struct {
-	UserUC userUC.UserUCInterface
-	TaskUC taskUC.TaskUCInterface
+	UserUC    userUC.UserUCInterface
+	TaskUC    taskUC.TaskUCInterface
+	TagUC     tagUC.TagUCInterface
+	ProjectUC projectUC.ProjectUCInterface
 }
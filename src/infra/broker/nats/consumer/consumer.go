@@ -0,0 +1,97 @@
+package nats_consumer
+
+import (
+	"fmt"
+	"sync"
+
+	"todo_list/src/infra/broker/nats"
+
+	natsgo "github.com/nats-io/nats.go"
+	"github.com/sirupsen/logrus"
+)
+
+// HandlerFunc memproses payload pesan yang diterima dari NATS
+type HandlerFunc func(data []byte) error
+
+// ConsumerInterface mendefinisikan kontrak untuk consumer NATS
+type ConsumerInterface interface {
+	Subscribe(subject string, handler HandlerFunc) error // Subscribe ke subject menggunakan queue group
+	Failures(subject string) uint64                      // Jumlah pesan yang gagal diproses per subject
+	Close()                                              // Menghentikan semua subscription
+}
+
+// SubscribeWorkerImpl adalah implementasi dari ConsumerInterface
+type SubscribeWorkerImpl struct {
+	nats     *nats.Nats // Menyimpan instance koneksi NATS
+	queue    string     // Nama queue group agar pesan dibagi rata antar instance
+	logger   *logrus.Logger
+	mu       sync.Mutex
+	subs     []*natsgo.Subscription
+	failures map[string]uint64
+}
+
+// NewSubscribeWorker membuat instance baru dari SubscribeWorkerImpl
+func NewSubscribeWorker(Nats *nats.Nats, queue string, logger *logrus.Logger) ConsumerInterface {
+	return &SubscribeWorkerImpl{
+		nats:     Nats,
+		queue:    queue,
+		logger:   logger,
+		failures: map[string]uint64{},
+	}
+}
+
+// Subscribe mendaftarkan handler untuk subject tertentu di NATS
+func (c *SubscribeWorkerImpl) Subscribe(subject string, handler HandlerFunc) error {
+	// Pastikan koneksi NATS sudah terhubung
+	if c.nats == nil || c.nats.Conn == nil || !c.nats.Conn.IsConnected() {
+		return fmt.Errorf("NATS connection is not established")
+	}
+
+	sub, err := c.nats.Conn.QueueSubscribe(subject, c.queue, func(msg *natsgo.Msg) {
+		if err := handler(msg.Data); err != nil {
+			// Catat dan hitung pesan yang gagal diproses
+			total := c.addFailure(subject)
+			c.logger.Errorf("failed to process message on [%s] (total failures: %d): %s", subject, total, err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.subs = append(c.subs, sub)
+	c.mu.Unlock()
+
+	c.logger.Printf("subscribed to [%s] with queue [%s]", subject, c.queue)
+
+	return nil
+}
+
+// Failures mengembalikan jumlah pesan yang gagal diproses untuk subject tertentu
+func (c *SubscribeWorkerImpl) Failures(subject string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.failures[subject]
+}
+
+// Close menghentikan semua subscription dan menunggu pesan yang sedang diproses
+func (c *SubscribeWorkerImpl) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, sub := range c.subs {
+		if err := sub.Drain(); err != nil {
+			c.logger.Errorf("error draining subscription [%s]: %s", sub.Subject, err)
+		}
+	}
+	c.subs = nil
+}
+
+func (c *SubscribeWorkerImpl) addFailure(subject string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.failures[subject]++
+	return c.failures[subject]
+}
@@ -36,6 +36,7 @@ type SqlDbConf struct {
 }
 
 type RedisConf struct {
+	Status       string // Redis aktif jika bernilai "1"
 	Host         string // Alamat host Redis
 	Port         string // Port Redis
 	PoolSize     int    // Maksimum jumlah koneksi dalam pool
@@ -50,14 +51,32 @@ type NatsConf struct {
 	NatsTimeOut int
 }
 
+type SchedulerConf struct {
+	ExpirySweepInterval  int // Interval sweeper kadaluarsa dalam detik, 0 berarti nonaktif
+	ExpirySweepBatchSize int // Jumlah maksimum task yang diproses per batch
+	TrashPurgeInterval   int // Interval pembersihan trash dalam detik, 0 berarti nonaktif
+	TrashRetentionDays   int // Lama task disimpan di trash sebelum dihapus permanen
+	ReminderInterval     int // Interval pengiriman pengingat task dalam detik, 0 berarti nonaktif
+	ReminderBatchSize    int // Jumlah maksimum pengingat yang dikirim per batch
+}
+
+type BlobConf struct {
+	Driver      string // Penyimpanan file lampiran, saat ini hanya "local"
+	LocalPath   string // Direktori root untuk driver local
+	MaxFileSize int64  // Ukuran maksimum satu file lampiran dalam byte
+	UserQuota   int64  // Total ukuran lampiran maksimum per user dalam byte
+}
+
 // Config ...
 type Config struct {
-	App   AppConf
-	Http  HttpConf
-	Log   LogConf
-	SqlDb SqlDbConf
-	Redis RedisConf
-	Nats  NatsConf
+	App       AppConf
+	Http      HttpConf
+	Log       LogConf
+	SqlDb     SqlDbConf
+	Redis     RedisConf
+	Nats      NatsConf
+	Scheduler SchedulerConf
+	Blob      BlobConf
 }
 
 // NewConfig ...
@@ -97,25 +116,26 @@ func Make() Config {
 		sqldb.MaxLifeTimeConnMinutes = dBMaxLifeTimeConnMinutes
 	}
 
-	// redis := RedisConf{
-	// 	Host: os.Getenv("REDIS_HOST"),
-	// 	Port: os.Getenv("REDIS_PORT"),
-	// }
+	redis := RedisConf{
+		Status: os.Getenv("REDIS_STATUS"),
+		Host:   os.Getenv("REDIS_HOST"),
+		Port:   os.Getenv("REDIS_PORT"),
+	}
 
-	// redisPoolSize, err := strconv.Atoi(os.Getenv("REDIS_POOL_SIZE"))
-	// if err == nil {
-	// 	redis.PoolSize = redisPoolSize
-	// }
+	redisPoolSize, err := strconv.Atoi(os.Getenv("REDIS_POOL_SIZE"))
+	if err == nil {
+		redis.PoolSize = redisPoolSize
+	}
 
-	// redisMinIdleConns, err := strconv.Atoi(os.Getenv("REDIS_MIN_IDLE_CON"))
-	// if err == nil {
-	// 	redis.MinIdleConns = redisMinIdleConns
-	// }
+	redisMinIdleConns, err := strconv.Atoi(os.Getenv("REDIS_MIN_IDLE_CON"))
+	if err == nil {
+		redis.MinIdleConns = redisMinIdleConns
+	}
 
-	// redisIdleTimeout, err := strconv.Atoi(os.Getenv("REDIS_IDLE_TIME_OUT_MINUTE"))
-	// if err == nil {
-	// 	redis.IdleTimeout = redisIdleTimeout
-	// }
+	redisIdleTimeout, err := strconv.Atoi(os.Getenv("REDIS_IDLE_TIME_OUT_MINUTE"))
+	if err == nil {
+		redis.IdleTimeout = redisIdleTimeout
+	}
 
 	nats := NatsConf{
 		NatsHost:   os.Getenv("NATS_HOST"),
@@ -127,6 +147,70 @@ func Make() Config {
 		nats.NatsTimeOut = natsTimeOut
 	}
 
+	scheduler := SchedulerConf{
+		ExpirySweepInterval:  60,
+		ExpirySweepBatchSize: 100,
+		TrashPurgeInterval:   3600,
+		TrashRetentionDays:   30,
+		ReminderInterval:     30,
+		ReminderBatchSize:    100,
+	}
+
+	expirySweepInterval, err := strconv.Atoi(os.Getenv("EXPIRY_SWEEP_INTERVAL_SECONDS"))
+	if err == nil {
+		scheduler.ExpirySweepInterval = expirySweepInterval
+	}
+
+	expirySweepBatchSize, err := strconv.Atoi(os.Getenv("EXPIRY_SWEEP_BATCH_SIZE"))
+	if err == nil && expirySweepBatchSize > 0 {
+		scheduler.ExpirySweepBatchSize = expirySweepBatchSize
+	}
+
+	trashPurgeInterval, err := strconv.Atoi(os.Getenv("TRASH_PURGE_INTERVAL_SECONDS"))
+	if err == nil {
+		scheduler.TrashPurgeInterval = trashPurgeInterval
+	}
+
+	trashRetentionDays, err := strconv.Atoi(os.Getenv("TRASH_RETENTION_DAYS"))
+	if err == nil && trashRetentionDays >= 0 {
+		scheduler.TrashRetentionDays = trashRetentionDays
+	}
+
+	reminderInterval, err := strconv.Atoi(os.Getenv("REMINDER_INTERVAL_SECONDS"))
+	if err == nil {
+		scheduler.ReminderInterval = reminderInterval
+	}
+
+	reminderBatchSize, err := strconv.Atoi(os.Getenv("REMINDER_BATCH_SIZE"))
+	if err == nil && reminderBatchSize > 0 {
+		scheduler.ReminderBatchSize = reminderBatchSize
+	}
+
+	blob := BlobConf{
+		Driver:      os.Getenv("BLOB_DRIVER"),
+		LocalPath:   os.Getenv("BLOB_LOCAL_PATH"),
+		MaxFileSize: 10 << 20,
+		UserQuota:   100 << 20,
+	}
+
+	// set default blob store to local filesystem
+	if blob.Driver == "" {
+		blob.Driver = "local"
+	}
+	if blob.LocalPath == "" {
+		blob.LocalPath = "storage"
+	}
+
+	attachmentMaxSize, err := strconv.ParseInt(os.Getenv("ATTACHMENT_MAX_SIZE_MB"), 10, 64)
+	if err == nil && attachmentMaxSize > 0 {
+		blob.MaxFileSize = attachmentMaxSize << 20
+	}
+
+	attachmentUserQuota, err := strconv.ParseInt(os.Getenv("ATTACHMENT_USER_QUOTA_MB"), 10, 64)
+	if err == nil && attachmentUserQuota > 0 {
+		blob.UserQuota = attachmentUserQuota << 20
+	}
+
 	http := HttpConf{
 		Port:       os.Getenv("HTTP_PORT"),
 		XRequestID: os.Getenv("HTTP_REQUEST_ID"),
@@ -152,12 +236,14 @@ func Make() Config {
 	}
 
 	config := Config{
-		App:   app,
-		Http:  http,
-		Log:   log,
-		SqlDb: sqldb,
-		// Redis: redis,
-		Nats: nats,
+		App:       app,
+		Http:      http,
+		Log:       log,
+		SqlDb:     sqldb,
+		Redis:     redis,
+		Nats:      nats,
+		Scheduler: scheduler,
+		Blob:      blob,
 	}
 
 	return config
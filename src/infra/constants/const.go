@@ -1,7 +1,12 @@
 package constants
 
 const (
-	ADD_TASK    = "addtask"
-	FINISH_TASK = "finishtask"
-	TASK_QUEUE  = "taskQueue"
+	ADD_TASK        = "addtask"
+	FINISH_TASK     = "finishtask"
+	TASK_EXPIRED    = "taskexpired"
+	TASK_REMINDER   = "taskreminder"
+	TASK_COMMENTED  = "taskcommented"
+	TASK_TRANSITION = "tasktransition"
+	TASK_BULK       = "taskbulk"
+	TASK_QUEUE      = "taskQueue"
 )
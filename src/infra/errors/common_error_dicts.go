@@ -9,6 +9,25 @@ const (
 	FAILED_CREATE_DATA     ErrorCode = 1005
 	USER_ALREADY_EXIST     ErrorCode = 1006
 	FAILED_SENDING_MESSAGE ErrorCode = 1007
+	TASK_NOT_FOUND         ErrorCode = 1008
+	ITEM_NOT_FOUND         ErrorCode = 1009
+	TAG_NOT_FOUND          ErrorCode = 1010
+	TAG_ALREADY_EXIST      ErrorCode = 1011
+	PROJECT_NOT_FOUND      ErrorCode = 1012
+	RECURRENCE_NOT_FOUND   ErrorCode = 1013
+	TASK_BLOCKED           ErrorCode = 1014
+	DEPENDENCY_CYCLE       ErrorCode = 1015
+	DEPENDENCY_NOT_FOUND   ErrorCode = 1016
+	PERMISSION_DENIED      ErrorCode = 1017
+	USER_NOT_FOUND         ErrorCode = 1018
+	SHARE_NOT_FOUND        ErrorCode = 1019
+	COMMENT_NOT_FOUND      ErrorCode = 1020
+	ATTACHMENT_NOT_FOUND   ErrorCode = 1021
+	ATTACHMENT_TOO_LARGE   ErrorCode = 1022
+	QUOTA_EXCEEDED         ErrorCode = 1023
+	INVALID_TRANSITION     ErrorCode = 1024
+	TIMER_RUNNING          ErrorCode = 1025
+	TIMER_NOT_RUNNING      ErrorCode = 1026
 )
 
 var errorCodes = map[ErrorCode]*CommonError{
@@ -51,4 +70,99 @@ var errorCodes = map[ErrorCode]*CommonError{
 		SystemMessage: "message_cant_be_send.",
 		ErrorCode:     FAILED_SENDING_MESSAGE,
 	},
+	TASK_NOT_FOUND: {
+		ClientMessage: "Task Not Found.",
+		SystemMessage: "Task does not exist or does not belong to the user.",
+		ErrorCode:     TASK_NOT_FOUND,
+	},
+	ITEM_NOT_FOUND: {
+		ClientMessage: "Checklist Item Not Found.",
+		SystemMessage: "Checklist item does not exist in the task.",
+		ErrorCode:     ITEM_NOT_FOUND,
+	},
+	TAG_NOT_FOUND: {
+		ClientMessage: "Tag Not Found.",
+		SystemMessage: "Tag does not exist or does not belong to the user.",
+		ErrorCode:     TAG_NOT_FOUND,
+	},
+	TAG_ALREADY_EXIST: {
+		ClientMessage: "Tag Already Exist.",
+		SystemMessage: "User already has a tag with the same name.",
+		ErrorCode:     TAG_ALREADY_EXIST,
+	},
+	PROJECT_NOT_FOUND: {
+		ClientMessage: "Project Not Found.",
+		SystemMessage: "Project does not exist or does not belong to the user.",
+		ErrorCode:     PROJECT_NOT_FOUND,
+	},
+	RECURRENCE_NOT_FOUND: {
+		ClientMessage: "Recurrence Not Found.",
+		SystemMessage: "Task is not part of a recurring series owned by the user.",
+		ErrorCode:     RECURRENCE_NOT_FOUND,
+	},
+	TASK_BLOCKED: {
+		ClientMessage: "Task Is Blocked.",
+		SystemMessage: "Task still has pending tasks it depends on.",
+		ErrorCode:     TASK_BLOCKED,
+	},
+	DEPENDENCY_CYCLE: {
+		ClientMessage: "Dependency Cycle.",
+		SystemMessage: "Adding the dependency would create a cycle between tasks.",
+		ErrorCode:     DEPENDENCY_CYCLE,
+	},
+	DEPENDENCY_NOT_FOUND: {
+		ClientMessage: "Dependency Not Found.",
+		SystemMessage: "Task does not depend on the given task.",
+		ErrorCode:     DEPENDENCY_NOT_FOUND,
+	},
+	PERMISSION_DENIED: {
+		ClientMessage: "Permission Denied.",
+		SystemMessage: "User does not have the required permission on the resource.",
+		ErrorCode:     PERMISSION_DENIED,
+	},
+	USER_NOT_FOUND: {
+		ClientMessage: "User Not Found.",
+		SystemMessage: "No other registered user has the given email.",
+		ErrorCode:     USER_NOT_FOUND,
+	},
+	SHARE_NOT_FOUND: {
+		ClientMessage: "Share Not Found.",
+		SystemMessage: "Resource is not shared with the given user.",
+		ErrorCode:     SHARE_NOT_FOUND,
+	},
+	COMMENT_NOT_FOUND: {
+		ClientMessage: "Comment Not Found.",
+		SystemMessage: "Comment does not exist in the task or was not written by the user.",
+		ErrorCode:     COMMENT_NOT_FOUND,
+	},
+	ATTACHMENT_NOT_FOUND: {
+		ClientMessage: "Attachment Not Found.",
+		SystemMessage: "Attachment does not exist in the task.",
+		ErrorCode:     ATTACHMENT_NOT_FOUND,
+	},
+	ATTACHMENT_TOO_LARGE: {
+		ClientMessage: "Attachment Too Large.",
+		SystemMessage: "Attachment exceeds the maximum file size.",
+		ErrorCode:     ATTACHMENT_TOO_LARGE,
+	},
+	QUOTA_EXCEEDED: {
+		ClientMessage: "Storage Quota Exceeded.",
+		SystemMessage: "Attachment would exceed the storage quota of the user.",
+		ErrorCode:     QUOTA_EXCEEDED,
+	},
+	INVALID_TRANSITION: {
+		ClientMessage: "Invalid Status Transition.",
+		SystemMessage: "Task status does not allow the requested transition.",
+		ErrorCode:     INVALID_TRANSITION,
+	},
+	TIMER_RUNNING: {
+		ClientMessage: "Timer Already Running.",
+		SystemMessage: "User already has a running timer.",
+		ErrorCode:     TIMER_RUNNING,
+	},
+	TIMER_NOT_RUNNING: {
+		ClientMessage: "Timer Not Running.",
+		SystemMessage: "User has no running timer on the task.",
+		ErrorCode:     TIMER_NOT_RUNNING,
+	},
 }
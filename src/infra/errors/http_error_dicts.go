@@ -11,4 +11,23 @@ var httpCode = map[ErrorCode]int{
 	UNAUTHORIZED:          http.StatusUnauthorized,
 	FAILED_RETRIEVE_DATA:  http.StatusInternalServerError,
 	USER_ALREADY_EXIST:    http.StatusConflict,
+	TASK_NOT_FOUND:        http.StatusNotFound,
+	ITEM_NOT_FOUND:        http.StatusNotFound,
+	TAG_NOT_FOUND:         http.StatusNotFound,
+	TAG_ALREADY_EXIST:     http.StatusConflict,
+	PROJECT_NOT_FOUND:     http.StatusNotFound,
+	RECURRENCE_NOT_FOUND:  http.StatusNotFound,
+	TASK_BLOCKED:          http.StatusConflict,
+	DEPENDENCY_CYCLE:      http.StatusConflict,
+	DEPENDENCY_NOT_FOUND:  http.StatusNotFound,
+	PERMISSION_DENIED:     http.StatusForbidden,
+	USER_NOT_FOUND:        http.StatusNotFound,
+	SHARE_NOT_FOUND:       http.StatusNotFound,
+	COMMENT_NOT_FOUND:     http.StatusNotFound,
+	ATTACHMENT_NOT_FOUND:  http.StatusNotFound,
+	ATTACHMENT_TOO_LARGE:  http.StatusRequestEntityTooLarge,
+	QUOTA_EXCEEDED:        http.StatusRequestEntityTooLarge,
+	INVALID_TRANSITION:    http.StatusConflict,
+	TIMER_RUNNING:         http.StatusConflict,
+	TIMER_NOT_RUNNING:     http.StatusNotFound,
 }
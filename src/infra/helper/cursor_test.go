@@ -0,0 +1,27 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	expiresAt := time.Date(2025, 3, 16, 12, 41, 0, 123, time.UTC)
+	cursor := EncodeCursor(expiresAt, 42)
+
+	gotExpiresAt, gotID, err := DecodeCursor(cursor)
+
+	assert.Nil(t, err)
+	assert.True(t, expiresAt.Equal(gotExpiresAt), "expires_at should survive the round trip")
+	assert.Equal(t, int64(42), gotID)
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	for _, cursor := range []string{"", "not base64!", EncodeCursor(time.Time{}, 1)[:4]} {
+		_, _, err := DecodeCursor(cursor)
+
+		assert.Equal(t, ErrInvalidCursor, err, "cursor %q should be rejected", cursor)
+	}
+}
@@ -0,0 +1,79 @@
+package helper
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// MaxReminderOffset adalah jarak terjauh pengingat sebelum expires_at
+const MaxReminderOffset = 30 * 24 * time.Hour
+
+// reminderUnits adalah satuan offset pengingat dari yang terbesar
+var reminderUnits = []struct {
+	suffix   string
+	duration time.Duration
+}{
+	{"w", 7 * 24 * time.Hour},
+	{"d", 24 * time.Hour},
+	{"h", time.Hour},
+	{"m", time.Minute},
+}
+
+// ParseReminderOffset mengubah offset pengingat seperti 30m, 1h, 1d, atau 1w menjadi durasi
+func ParseReminderOffset(s string) (time.Duration, error) {
+	if len(s) < 2 {
+		return 0, errors.New("must be a number followed by m, h, d, or w")
+	}
+
+	for _, unit := range reminderUnits {
+		if s[len(s)-1:] != unit.suffix {
+			continue
+		}
+
+		n, err := strconv.Atoi(s[:len(s)-1])
+		if err != nil || n <= 0 {
+			return 0, errors.New("must be a positive number followed by m, h, d, or w")
+		}
+
+		offset := time.Duration(n) * unit.duration
+		if offset > MaxReminderOffset {
+			return 0, errors.New("must not be more than 30d")
+		}
+		return offset, nil
+	}
+
+	return 0, errors.New("must be a number followed by m, h, d, or w")
+}
+
+// ReminderSeconds mengubah daftar offset pengingat menjadi detik tanpa duplikat.
+// Offset yang tidak valid diabaikan karena sudah ditolak saat validasi request.
+func ReminderSeconds(offsets []string) []int64 {
+	seen := map[int64]bool{}
+	resp := []int64{}
+	for _, s := range offsets {
+		offset, err := ParseReminderOffset(s)
+		if err != nil {
+			continue
+		}
+
+		seconds := int64(offset / time.Second)
+		if seen[seconds] {
+			continue
+		}
+		seen[seconds] = true
+		resp = append(resp, seconds)
+	}
+	return resp
+}
+
+// FormatReminderOffset mengubah offset pengingat dalam detik ke satuan terbesar yang pas, contoh 86400 menjadi 1d
+func FormatReminderOffset(seconds int64) string {
+	offset := time.Duration(seconds) * time.Second
+	for _, unit := range reminderUnits {
+		if offset >= unit.duration && offset%unit.duration == 0 {
+			return strconv.FormatInt(int64(offset/unit.duration), 10) + unit.suffix
+		}
+	}
+	return strconv.FormatInt(seconds, 10) + "s"
+}
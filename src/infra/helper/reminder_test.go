@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReminderOffset(t *testing.T) {
+	cases := map[string]time.Duration{
+		"30m": 30 * time.Minute,
+		"1h":  time.Hour,
+		"1d":  24 * time.Hour,
+		"2w":  14 * 24 * time.Hour,
+	}
+
+	for s, expected := range cases {
+		offset, err := ParseReminderOffset(s)
+		assert.NoError(t, err, s)
+		assert.Equal(t, expected, offset, s)
+	}
+}
+
+func TestParseReminderOffsetInvalid(t *testing.T) {
+	for _, s := range []string{"", "h", "1", "0h", "-1h", "1.5h", "1s", "31d", "5w"} {
+		_, err := ParseReminderOffset(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestReminderSeconds(t *testing.T) {
+	seconds := ReminderSeconds([]string{"1d", "1h", "60m", "24h", "bad"})
+
+	assert.Equal(t, []int64{86400, 3600}, seconds)
+}
+
+func TestFormatReminderOffset(t *testing.T) {
+	assert.Equal(t, "1w", FormatReminderOffset(7*86400))
+	assert.Equal(t, "1d", FormatReminderOffset(86400))
+	assert.Equal(t, "36h", FormatReminderOffset(36*3600))
+	assert.Equal(t, "90m", FormatReminderOffset(90*60))
+}
@@ -0,0 +1,105 @@
+package helper
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// SearchTerms memecah kata kunci pencarian menjadi kata huruf kecil. Karakter selain
+// huruf dan angka dianggap pemisah, sama seperti parser 'simple' pada full-text search.
+func SearchTerms(input string) []string {
+	return strings.FieldsFunc(strings.ToLower(input), isSeparator)
+}
+
+// Highlight mengembalikan text yang sudah di-escape sebagai HTML dengan kata yang
+// diawali salah satu terms dibungkus <mark>. Text milik user tidak pernah dikirim
+// sebagai markup, hanya tag <mark> yang ditambahkan di sini.
+func Highlight(text string, terms []string) string {
+	tokens := tokenize(text)
+	return render(tokens, 0, len(tokens), terms)
+}
+
+// HighlightExcerpt seperti Highlight, tetapi hanya mengambil maxWords kata di sekitar
+// kata pertama yang cocok. String kosong dikembalikan jika tidak ada kata yang cocok.
+func HighlightExcerpt(text string, terms []string, maxWords int) string {
+	tokens := tokenize(text)
+
+	var words []int // Index token yang berupa kata
+	first := -1
+	for i, t := range tokens {
+		if !t.word {
+			continue
+		}
+		if first < 0 && matches(t.text, terms) {
+			first = len(words)
+		}
+		words = append(words, i)
+	}
+	if first < 0 {
+		return ""
+	}
+
+	// Sepertiga excerpt diambil sebelum kata yang cocok sebagai konteks
+	start := max(0, first-maxWords/3)
+	end := min(len(words), start+maxWords)
+	start = max(0, end-maxWords)
+
+	excerpt := render(tokens, words[start], words[end-1]+1, terms)
+	if start > 0 {
+		excerpt = "… " + excerpt
+	}
+	if end < len(words) {
+		excerpt += " …"
+	}
+	return excerpt
+}
+
+type token struct {
+	text string
+	word bool
+}
+
+// tokenize memecah text menjadi kata dan pemisah secara bergantian tanpa membuang karakter apa pun
+func tokenize(text string) []token {
+	var tokens []token
+	start, word := 0, false
+	for i, r := range text {
+		if i > start && isSeparator(r) == word {
+			tokens = append(tokens, token{text: text[start:i], word: word})
+			start = i
+		}
+		word = !isSeparator(r)
+	}
+	if start < len(text) {
+		tokens = append(tokens, token{text: text[start:], word: word})
+	}
+	return tokens
+}
+
+func render(tokens []token, from, to int, terms []string) string {
+	var b strings.Builder
+	for _, t := range tokens[from:to] {
+		if t.word && matches(t.text, terms) {
+			b.WriteString("<mark>" + html.EscapeString(t.text) + "</mark>")
+		} else {
+			b.WriteString(html.EscapeString(t.text))
+		}
+	}
+	return b.String()
+}
+
+// matches mengikuti prefix matching pada tsquery, misalnya "rap" cocok dengan "Rapat"
+func matches(word string, terms []string) bool {
+	word = strings.ToLower(word)
+	for _, term := range terms {
+		if strings.HasPrefix(word, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
@@ -0,0 +1,31 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"rapat", "kan"}, SearchTerms(" Rapat & kan:* "))
+	assert.Empty(t, SearchTerms("&|!"))
+}
+
+func TestHighlightEscapesHTML(t *testing.T) {
+	got := Highlight(`Rapat <script>alert("x")</script> & rapi`, []string{"rap", "script"})
+
+	assert.Equal(t, `<mark>Rapat</mark> &lt;<mark>script</mark>&gt;alert(&#34;x&#34;)&lt;/<mark>script</mark>&gt; &amp; <mark>rapi</mark>`, got)
+}
+
+func TestHighlightWithoutMatch(t *testing.T) {
+	assert.Equal(t, "a &lt;b&gt;", Highlight("a <b>", []string{"zzz"}))
+}
+
+func TestHighlightExcerpt(t *testing.T) {
+	text := "satu dua tiga empat lima enam tujuh delapan sembilan sepuluh"
+
+	assert.Equal(t, "… lima <mark>enam</mark> tujuh …", HighlightExcerpt(text, []string{"enam"}, 3))
+	assert.Equal(t, "<mark>satu</mark> dua tiga …", HighlightExcerpt(text, []string{"satu"}, 3))
+	assert.Equal(t, "… delapan sembilan <mark>sepuluh</mark>", HighlightExcerpt(text, []string{"sepuluh"}, 3))
+	assert.Equal(t, "", HighlightExcerpt(text, []string{"sebelas"}, 3))
+}
@@ -0,0 +1,23 @@
+package helper
+
+import "strings"
+
+// NormalizeTags merapikan nama tag: spasi di awal dan akhir dibuang, huruf dikecilkan,
+// nama kosong dan duplikat dihapus dengan urutan tetap dipertahankan
+func NormalizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	seen := map[string]bool{}
+	resp := []string{}
+	for _, tag := range tags {
+		name := strings.ToLower(strings.TrimSpace(tag))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		resp = append(resp, name)
+	}
+	return resp
+}
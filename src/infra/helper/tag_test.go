@@ -0,0 +1,18 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTags(t *testing.T) {
+	tags := NormalizeTags([]string{" Work", "urgent", "work", "", "  ", "URGENT", "home"})
+
+	assert.Equal(t, []string{"work", "urgent", "home"}, tags)
+}
+
+func TestNormalizeTagsNil(t *testing.T) {
+	assert.Nil(t, NormalizeTags(nil))
+	assert.Equal(t, []string{}, NormalizeTags([]string{}))
+}
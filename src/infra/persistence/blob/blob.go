@@ -0,0 +1,45 @@
+package blob
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"todo_list/src/infra/config"
+)
+
+// ErrBlobNotFound dikembalikan ketika blob dengan key tersebut tidak ada
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobStore mendefinisikan kontrak penyimpanan file lampiran.
+// Key berupa path relatif dengan pemisah "/", contoh tasks/1/abc.
+type BlobStore interface {
+	Put(key string, r io.Reader) (int64, error) // Simpan isi reader dan kembalikan jumlah byte yang ditulis
+	Open(key string) (io.ReadCloser, error)     // Buka blob untuk dibaca secara streaming
+	Delete(key string) error                    // Hapus satu blob, tidak error jika blob tidak ada
+	DeletePrefix(prefix string) error           // Hapus semua blob dengan prefix key tersebut
+}
+
+// Limits adalah batas ukuran lampiran yang diterapkan use case
+type Limits struct {
+	MaxFileSize int64 // Ukuran maksimum satu file dalam byte
+	UserQuota   int64 // Total ukuran lampiran maksimum per user dalam byte
+}
+
+// New membuat BlobStore sesuai driver pada konfigurasi
+func New(conf config.BlobConf) (BlobStore, error) {
+	switch conf.Driver {
+	case "local":
+		return NewLocalStore(conf.LocalPath)
+	default:
+		return nil, fmt.Errorf("unknown blob driver %q", conf.Driver)
+	}
+}
+
+// NewLimits mengambil batas ukuran lampiran dari konfigurasi
+func NewLimits(conf config.BlobConf) Limits {
+	return Limits{
+		MaxFileSize: conf.MaxFileSize,
+		UserQuota:   conf.UserQuota,
+	}
+}
@@ -0,0 +1,57 @@
+package blob
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStorePutOpenDelete(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	size, err := store.Put("tasks/1/a", strings.NewReader("hello"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), size)
+
+	r, err := store.Open("tasks/1/a")
+	assert.NoError(t, err)
+	data, _ := io.ReadAll(r)
+	r.Close()
+	assert.Equal(t, "hello", string(data))
+
+	assert.NoError(t, store.Delete("tasks/1/a"))
+	assert.NoError(t, store.Delete("tasks/1/a"))
+	_, err = store.Open("tasks/1/a")
+	assert.Equal(t, ErrBlobNotFound, err)
+}
+
+func TestLocalStoreDeletePrefix(t *testing.T) {
+	store, _ := NewLocalStore(t.TempDir())
+	store.Put("tasks/1/a", strings.NewReader("a"))
+	store.Put("tasks/1/b", strings.NewReader("b"))
+	store.Put("tasks/12/a", strings.NewReader("c"))
+
+	assert.NoError(t, store.DeletePrefix("tasks/1"))
+
+	_, err := store.Open("tasks/1/b")
+	assert.Equal(t, ErrBlobNotFound, err)
+	r, err := store.Open("tasks/12/a")
+	assert.NoError(t, err)
+	r.Close()
+}
+
+func TestLocalStoreKeyStaysInRoot(t *testing.T) {
+	root := t.TempDir()
+	store, _ := NewLocalStore(root + "/blobs")
+
+	_, err := store.Put("../../escape", strings.NewReader("x"))
+	assert.NoError(t, err)
+	_, err = store.Open("escape")
+	assert.NoError(t, err)
+
+	_, err = store.Put("", strings.NewReader("x"))
+	assert.Error(t, err)
+}
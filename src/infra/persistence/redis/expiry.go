@@ -0,0 +1,122 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	taskExpiryKeyPrefix = "task:expire:"           // Prefix key TTL untuk setiap task
+	expiredEventPattern = "__keyevent@*__:expired" // Channel notifikasi key yang kadaluarsa
+	keyspaceEventsFlags = "Ex"                     // Flag notifikasi yang dibutuhkan oleh listener
+)
+
+// TaskExpiryInterface mendefinisikan kontrak untuk pelacakan kadaluarsa task
+type TaskExpiryInterface interface {
+	Active() bool                                                 // Apakah Redis tersedia
+	Schedule(taskID int64, expiresAt time.Time) error             // Set key TTL berdasarkan expires_at
+	Cancel(taskID int64) error                                    // Hapus key TTL task
+	Listen(ctx context.Context, handler func(taskID int64) error) // Dengarkan event key yang kadaluarsa
+}
+
+// TaskExpiryImpl adalah implementasi dari TaskExpiryInterface
+type TaskExpiryImpl struct {
+	redis  *Redis
+	logger *logrus.Logger
+}
+
+// NewTaskExpiry membuat instance baru dari TaskExpiryImpl
+func NewTaskExpiry(r *Redis, logger *logrus.Logger) TaskExpiryInterface {
+	return &TaskExpiryImpl{redis: r, logger: logger}
+}
+
+// Active mengembalikan true jika koneksi Redis tersedia
+func (e *TaskExpiryImpl) Active() bool {
+	return e.redis != nil && e.redis.Status
+}
+
+// Schedule menyimpan key dengan TTL sampai expires_at.
+// Jika Redis tidak aktif, method ini tidak melakukan apa-apa.
+func (e *TaskExpiryImpl) Schedule(taskID int64, expiresAt time.Time) error {
+	if !e.Active() {
+		return nil
+	}
+
+	// Task yang sudah lewat waktunya tetap dijadwalkan agar langsung memicu event expired
+	ttl := time.Until(expiresAt)
+	if ttl < time.Millisecond {
+		ttl = time.Millisecond
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return e.redis.Client.Set(ctx, taskExpiryKey(taskID), taskID, ttl).Err()
+}
+
+// Cancel menghapus key TTL task, misalnya ketika task sudah selesai
+func (e *TaskExpiryImpl) Cancel(taskID int64) error {
+	if !e.Active() {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	return e.redis.Client.Del(ctx, taskExpiryKey(taskID)).Err()
+}
+
+// Listen berlangganan ke notifikasi key yang kadaluarsa dan memanggil handler
+// untuk setiap key task. Method ini berjalan sampai ctx dibatalkan.
+func (e *TaskExpiryImpl) Listen(ctx context.Context, handler func(taskID int64) error) {
+	if !e.Active() {
+		return
+	}
+
+	// Aktifkan notifikasi keyspace, abaikan jika server tidak mengizinkan CONFIG SET
+	if err := e.redis.Client.ConfigSet(ctx, "notify-keyspace-events", keyspaceEventsFlags).Err(); err != nil {
+		e.logger.Warnf("unable to set notify-keyspace-events, make sure it is enabled on the server: %s", err)
+	}
+
+	pubsub := e.redis.Client.PSubscribe(ctx, expiredEventPattern)
+	defer pubsub.Close()
+
+	e.logger.Printf("listening redis expired events on [%s]", expiredEventPattern)
+
+	ch := pubsub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			e.logger.Println("redis expiry listener exiting")
+			return
+		case msg, ok := <-ch:
+			if !ok {
+				return
+			}
+
+			// Abaikan key yang bukan milik task
+			if !strings.HasPrefix(msg.Payload, taskExpiryKeyPrefix) {
+				continue
+			}
+
+			taskID, err := strconv.ParseInt(strings.TrimPrefix(msg.Payload, taskExpiryKeyPrefix), 10, 64)
+			if err != nil {
+				e.logger.Errorf("invalid task expiry key %s: %s", msg.Payload, err)
+				continue
+			}
+
+			if err := handler(taskID); err != nil {
+				e.logger.Errorf("failed to expire task %d: %s", taskID, err)
+			}
+		}
+	}
+}
+
+// taskExpiryKey membuat nama key TTL untuk task
+func taskExpiryKey(taskID int64) string {
+	return taskExpiryKeyPrefix + strconv.FormatInt(taskID, 10)
+}
@@ -0,0 +1,62 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"todo_list/src/infra/config"
+
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/sirupsen/logrus"
+)
+
+// Redis menyimpan status koneksi dan instance client Redis
+type Redis struct {
+	Status bool            // Menyimpan status apakah Redis aktif atau tidak
+	Client *goredis.Client // Objek client Redis
+}
+
+// New membuat koneksi ke Redis berdasarkan konfigurasi yang diberikan.
+// Redis bersifat opsional, jika gagal terkoneksi service tetap berjalan tanpa Redis.
+func New(conf config.RedisConf, logger *logrus.Logger) *Redis {
+	var r = new(Redis)
+
+	// Redis hanya digunakan jika diaktifkan pada konfigurasi
+	if conf.Status != "1" {
+		logger.Println("redis is disabled")
+		return r
+	}
+
+	r.Client = goredis.NewClient(&goredis.Options{
+		Addr:            fmt.Sprintf("%s:%s", conf.Host, conf.Port),
+		PoolSize:        conf.PoolSize,                                 // Maksimum jumlah koneksi dalam pool
+		MinIdleConns:    conf.MinIdleConns,                             // Minimum koneksi idle yang dipertahankan
+		ConnMaxIdleTime: time.Duration(conf.IdleTimeout) * time.Minute, // Waktu sebelum koneksi idle ditutup
+	})
+
+	// Mengecek apakah koneksi ke Redis berhasil
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := r.Client.Ping(ctx).Err(); err != nil {
+		logger.Printf("error connecting redis, continue without redis. %s", err.Error())
+		r.Client.Close()
+		r.Client = nil
+		return r
+	}
+
+	r.Status = true
+	logger.Printf("redis connection %s success", conf.Host)
+
+	return r
+}
+
+// Close menutup koneksi Redis jika aktif
+func (r *Redis) Close() error {
+	if r.Client == nil {
+		return nil
+	}
+
+	return r.Client.Close()
+}
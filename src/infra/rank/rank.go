@@ -0,0 +1,156 @@
+// Package rank mengimplementasikan fractional indexing leksikografis untuk
+// urutan manual.
+//
+// Rank adalah string base-62 ("0-9A-Za-z") yang dibandingkan byte per byte,
+// sehingga kolom database yang menyimpannya harus memakai collation "C".
+// Rank tidak pernah diakhiri digit terkecil ("0") agar selalu ada ruang
+// sebelum rank mana pun. String kosong berarti batas awal atau akhir daftar.
+package rank
+
+import (
+	"errors"
+	"strings"
+)
+
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// MaxLength adalah panjang rank maksimal sebelum daftar perlu di-rebalance
+const MaxLength = 24
+
+var (
+	ErrInvalidRank  = errors.New("rank tidak valid")
+	ErrInvalidRange = errors.New("rank bawah harus lebih kecil dari rank atas")
+)
+
+// Between mengembalikan rank di antara a dan b. a kosong berarti awal daftar,
+// b kosong berarti akhir daftar.
+func Between(a, b string) (string, error) {
+	if !valid(a) || !valid(b) {
+		return "", ErrInvalidRank
+	}
+	if a != "" && b != "" && a >= b {
+		return "", ErrInvalidRange
+	}
+
+	return midpoint(a, b), nil
+}
+
+// BetweenN mengembalikan n rank berurutan di antara a dan b. Rank dibagi
+// secara biner sehingga panjangnya hanya bertambah sekitar log62(n).
+func BetweenN(a, b string, n int) ([]string, error) {
+	if n <= 0 {
+		return nil, nil
+	}
+
+	mid, err := Between(a, b)
+	if err != nil {
+		return nil, err
+	}
+
+	left, err := BetweenN(a, mid, n/2)
+	if err != nil {
+		return nil, err
+	}
+
+	right, err := BetweenN(mid, b, n-1-n/2)
+	if err != nil {
+		return nil, err
+	}
+
+	ranks := append(left, mid)
+	return append(ranks, right...), nil
+}
+
+// Spread mengembalikan n rank berjarak sama dengan panjang yang seragam.
+// Rank hanya memakai paruh awal ruang rank agar task baru yang ditambahkan
+// di akhir daftar masih punya ruang tanpa cepat memanjang.
+func Spread(n int) []string {
+	if n <= 0 {
+		return nil
+	}
+
+	length, space := 1, uint64(len(digits))
+	for space/2/uint64(n+1) < uint64(len(digits)) {
+		length++
+		space *= uint64(len(digits))
+	}
+
+	step := space / 2 / uint64(n+1)
+	ranks := make([]string, n)
+	for i := range ranks {
+		ranks[i] = encode(step*uint64(i+1), length)
+	}
+
+	return ranks
+}
+
+// encode mengubah v menjadi rank sepanjang length tanpa digit "0" di akhir.
+// Membuang "0" di akhir tidak mengubah urutan karena rank yang lebih pendek
+// diperlakukan seolah diisi "0".
+func encode(v uint64, length int) string {
+	buf := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		buf[i] = digits[v%uint64(len(digits))]
+		v /= uint64(len(digits))
+	}
+
+	return strings.TrimRight(string(buf), digits[:1])
+}
+
+// midpoint mengasumsikan a < b atau b kosong, dan keduanya valid
+func midpoint(a, b string) string {
+	if b != "" {
+		// Lewati prefix yang sama, a yang lebih pendek dianggap diisi "0"
+		n := 0
+		for n < len(b) && digitAt(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	da := strings.IndexByte(digits, digitAt(a, 0))
+	db := len(digits)
+	if b != "" {
+		db = strings.IndexByte(digits, b[0])
+	}
+
+	if db-da > 1 {
+		return string(digits[(da+db+1)/2])
+	}
+
+	// Digit pertama berurutan, b yang lebih panjang berarti digit pertamanya saja sudah cukup
+	if b != "" && len(b) > 1 {
+		return b[:1]
+	}
+
+	rest := ""
+	if len(a) > 1 {
+		rest = a[1:]
+	}
+	return string(digits[da]) + midpoint(rest, "")
+}
+
+func digitAt(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return digits[0]
+}
+
+func valid(s string) bool {
+	if strings.HasSuffix(s, digits[:1]) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(digits, s[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package rank
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetween(t *testing.T) {
+	cases := []struct {
+		a, b, expected string
+	}{
+		{"", "", "V"},
+		{"V", "", "l"},
+		{"", "V", "G"},
+		{"1", "2", "1V"},
+		{"1", "101", "100V"},
+		{"z", "", "zV"},
+		{"A", "A1", "A0V"},
+	}
+
+	for _, c := range cases {
+		resp, err := Between(c.a, c.b)
+
+		assert.Nil(t, err)
+		assert.Equal(t, c.expected, resp, "between %q dan %q", c.a, c.b)
+		if c.a != "" {
+			assert.Less(t, c.a, resp)
+		}
+		if c.b != "" {
+			assert.Less(t, resp, c.b)
+		}
+	}
+}
+
+func TestBetweenInvalid(t *testing.T) {
+	_, err := Between("B", "A")
+	assert.Equal(t, ErrInvalidRange, err)
+
+	_, err = Between("A", "A")
+	assert.Equal(t, ErrInvalidRange, err)
+
+	_, err = Between("A0", "")
+	assert.Equal(t, ErrInvalidRank, err)
+
+	_, err = Between("", "A-")
+	assert.Equal(t, ErrInvalidRank, err)
+}
+
+func TestBetweenRepeatedGrowsSlowly(t *testing.T) {
+	lower, upper := "", ""
+	for i := 0; i < 100; i++ {
+		resp, err := Between(lower, upper)
+		assert.Nil(t, err)
+
+		// Bergantian menyisip tepat setelah batas bawah dan sebelum batas atas
+		if i%2 == 0 {
+			upper = resp
+		} else {
+			lower = resp
+		}
+	}
+
+	assert.Less(t, lower, upper)
+	assert.LessOrEqual(t, len(upper), 20)
+}
+
+func TestBetweenN(t *testing.T) {
+	resp, err := BetweenN("V", "", 100)
+
+	assert.Nil(t, err)
+	assert.Len(t, resp, 100)
+	assert.True(t, sort.StringsAreSorted(resp))
+	assert.Less(t, "V", resp[0])
+	for i := 1; i < len(resp); i++ {
+		assert.NotEqual(t, resp[i-1], resp[i])
+	}
+	for _, r := range resp {
+		assert.LessOrEqual(t, len(r), 4)
+	}
+}
+
+func TestSpread(t *testing.T) {
+	resp := Spread(1000)
+
+	assert.Len(t, resp, 1000)
+	assert.True(t, sort.StringsAreSorted(resp))
+	for i, r := range resp {
+		assert.True(t, valid(r))
+		assert.LessOrEqual(t, len(r), 3)
+		if i > 0 {
+			assert.NotEqual(t, resp[i-1], r)
+		}
+	}
+
+	// Paruh akhir ruang rank dibiarkan kosong untuk task baru
+	assert.Less(t, resp[len(resp)-1], "V")
+	assert.Nil(t, Spread(0))
+}
@@ -0,0 +1,379 @@
+// Package rrule mengimplementasikan subset RRULE RFC 5545 untuk task berulang.
+//
+// Bagian yang didukung: FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT,
+// UNTIL, BYDAY (termasuk urutan seperti 1MO atau -1FR untuk MONTHLY/YEARLY),
+// BYMONTHDAY, dan BYMONTH. Jam kejadian selalu mengikuti DTSTART. Untuk YEARLY,
+// urutan pada BYDAY dihitung di dalam bulan, bukan di dalam tahun.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Frequency adalah nilai FREQ pada RRULE
+type Frequency int
+
+const (
+	Daily Frequency = iota
+	Weekly
+	Monthly
+	Yearly
+)
+
+// maxPeriods membatasi jumlah periode yang diperiksa agar rule yang tidak
+// pernah menghasilkan kejadian (contoh BYMONTHDAY=31;BYMONTH=2) tidak berputar selamanya
+const maxPeriods = 100000
+
+var frequencies = map[string]Frequency{
+	"DAILY":   Daily,
+	"WEEKLY":  Weekly,
+	"MONTHLY": Monthly,
+	"YEARLY":  Yearly,
+}
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// ByDay adalah satu nilai BYDAY, N bernilai 0 jika tidak ada urutan
+type ByDay struct {
+	N       int
+	Weekday time.Weekday
+}
+
+// Rule adalah hasil parsing RRULE
+type Rule struct {
+	Freq       Frequency
+	Interval   int
+	Count      int
+	Until      *time.Time
+	ByDay      []ByDay
+	ByMonthDay []int
+	ByMonth    []time.Month
+}
+
+// Parse membaca RRULE seperti "FREQ=WEEKLY;BYDAY=MO,WE". Prefix "RRULE:" boleh disertakan.
+// UNTIL tanpa akhiran Z dibaca dalam UTC, gunakan ParseIn untuk seri dengan zona waktu lain.
+func Parse(value string) (*Rule, error) {
+	return ParseIn(value, time.UTC)
+}
+
+// ParseIn membaca RRULE seperti Parse, dengan UNTIL berupa tanggal atau waktu lokal
+// (tanpa akhiran Z) dibaca di zona waktu seri loc
+func ParseIn(value string, loc *time.Location) (*Rule, error) {
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return nil, errors.New("rrule: empty rule")
+	}
+
+	rule := &Rule{Interval: 1}
+	hasFreq := false
+	seen := map[string]bool{}
+
+	for _, part := range strings.Split(value, ";") {
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 || kv[1] == "" {
+			return nil, fmt.Errorf("rrule: invalid part %q", part)
+		}
+		key, val := strings.ToUpper(kv[0]), strings.ToUpper(kv[1])
+		if seen[key] {
+			return nil, fmt.Errorf("rrule: duplicate %s", key)
+		}
+		seen[key] = true
+
+		switch key {
+		case "FREQ":
+			freq, ok := frequencies[val]
+			if !ok {
+				return nil, fmt.Errorf("rrule: unsupported FREQ %q", val)
+			}
+			rule.Freq = freq
+			hasFreq = true
+		case "INTERVAL":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("rrule: invalid INTERVAL %q", val)
+			}
+			rule.Interval = n
+		case "COUNT":
+			n, err := strconv.Atoi(val)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("rrule: invalid COUNT %q", val)
+			}
+			rule.Count = n
+		case "UNTIL":
+			until, err := parseUntil(val, loc)
+			if err != nil {
+				return nil, err
+			}
+			rule.Until = &until
+		case "BYDAY":
+			for _, v := range strings.Split(val, ",") {
+				day, err := parseByDay(v)
+				if err != nil {
+					return nil, err
+				}
+				rule.ByDay = append(rule.ByDay, day)
+			}
+		case "BYMONTHDAY":
+			for _, v := range strings.Split(val, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n == 0 || n < -31 || n > 31 {
+					return nil, fmt.Errorf("rrule: invalid BYMONTHDAY %q", v)
+				}
+				rule.ByMonthDay = append(rule.ByMonthDay, n)
+			}
+		case "BYMONTH":
+			for _, v := range strings.Split(val, ",") {
+				n, err := strconv.Atoi(v)
+				if err != nil || n < 1 || n > 12 {
+					return nil, fmt.Errorf("rrule: invalid BYMONTH %q", v)
+				}
+				rule.ByMonth = append(rule.ByMonth, time.Month(n))
+			}
+		case "WKST":
+			if val != "MO" {
+				return nil, errors.New("rrule: only WKST=MO is supported")
+			}
+		default:
+			return nil, fmt.Errorf("rrule: unsupported part %s", key)
+		}
+	}
+
+	if !hasFreq {
+		return nil, errors.New("rrule: FREQ is required")
+	}
+	if rule.Count > 0 && rule.Until != nil {
+		return nil, errors.New("rrule: COUNT and UNTIL cannot be combined")
+	}
+	for _, day := range rule.ByDay {
+		if day.N != 0 && rule.Freq != Monthly && rule.Freq != Yearly {
+			return nil, errors.New("rrule: numbered BYDAY is only allowed with MONTHLY or YEARLY")
+		}
+	}
+
+	return rule, nil
+}
+
+// parseUntil membaca UNTIL dalam format waktu UTC (20250131T090000Z), atau tanggal (20250131)
+// dan waktu lokal (20250131T090000) di zona waktu loc
+func parseUntil(val string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", val); err == nil {
+		return t, nil
+	}
+
+	for _, layout := range []string{"20060102T150405", "20060102"} {
+		if t, err := time.ParseInLocation(layout, val, loc); err == nil {
+			if layout == "20060102" {
+				// Tanggal saja berarti sampai akhir hari tersebut
+				t = time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 0, loc)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("rrule: invalid UNTIL %q", val)
+}
+
+// parseByDay membaca satu nilai BYDAY seperti MO, 2TU, atau -1FR
+func parseByDay(val string) (ByDay, error) {
+	if len(val) < 2 {
+		return ByDay{}, fmt.Errorf("rrule: invalid BYDAY %q", val)
+	}
+
+	weekday, ok := weekdays[val[len(val)-2:]]
+	if !ok {
+		return ByDay{}, fmt.Errorf("rrule: invalid BYDAY %q", val)
+	}
+
+	day := ByDay{Weekday: weekday}
+	if prefix := val[:len(val)-2]; prefix != "" {
+		n, err := strconv.Atoi(prefix)
+		if err != nil || n == 0 || n < -53 || n > 53 {
+			return ByDay{}, fmt.Errorf("rrule: invalid BYDAY %q", val)
+		}
+		day.N = n
+	}
+	return day, nil
+}
+
+// Next mengembalikan kejadian pertama setelah after untuk seri yang dimulai pada dtstart.
+// Perhitungan dilakukan di zona waktu dtstart, sehingga jam kejadian tetap sama
+// walaupun terjadi pergantian daylight saving. DTSTART dihitung sebagai kejadian pertama.
+// Nilai false dikembalikan jika seri sudah selesai karena COUNT atau UNTIL.
+func (r *Rule) Next(dtstart time.Time, after time.Time) (time.Time, bool) {
+	count := 1 // DTSTART selalu menjadi kejadian pertama
+	if dtstart.After(after) {
+		return dtstart, true
+	}
+
+	for period := 0; period < maxPeriods; period++ {
+		for _, candidate := range r.candidates(dtstart, period) {
+			if !candidate.After(dtstart) {
+				continue
+			}
+			if r.Until != nil && candidate.After(*r.Until) {
+				return time.Time{}, false
+			}
+
+			count++
+			if r.Count > 0 && count > r.Count {
+				return time.Time{}, false
+			}
+			if candidate.After(after) {
+				return candidate, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// candidates menghasilkan kandidat kejadian yang terurut pada periode ke-n sejak dtstart
+func (r *Rule) candidates(dtstart time.Time, n int) []time.Time {
+	loc := dtstart.Location()
+	year, month, day := dtstart.Date()
+	step := n * r.Interval
+
+	var days []time.Time
+	switch r.Freq {
+	case Daily:
+		date := time.Date(year, month, day+step, 0, 0, 0, 0, loc)
+		if r.matchWeekday(date) && r.matchMonthDay(date) {
+			days = append(days, date)
+		}
+	case Weekly:
+		// Minggu dimulai hari Senin (WKST=MO)
+		offset := (int(dtstart.Weekday()) + 6) % 7
+		monday := time.Date(year, month, day-offset+7*step, 0, 0, 0, 0, loc)
+		for i := 0; i < 7; i++ {
+			date := monday.AddDate(0, 0, i)
+			if len(r.ByDay) == 0 && date.Weekday() != dtstart.Weekday() {
+				continue
+			}
+			if r.matchWeekday(date) {
+				days = append(days, date)
+			}
+		}
+	case Monthly:
+		first := time.Date(year, month+time.Month(step), 1, 0, 0, 0, 0, loc)
+		days = r.monthDays(first, day)
+	case Yearly:
+		months := r.ByMonth
+		if len(months) == 0 {
+			months = []time.Month{month}
+		}
+		for _, m := range months {
+			first := time.Date(year+step, m, 1, 0, 0, 0, 0, loc)
+			days = append(days, r.monthDays(first, day)...)
+		}
+	}
+
+	hour, min, sec := dtstart.Clock()
+	resp := make([]time.Time, 0, len(days))
+	for _, date := range days {
+		if !r.matchMonth(date) {
+			continue
+		}
+		y, m, d := date.Date()
+		resp = append(resp, time.Date(y, m, d, hour, min, sec, dtstart.Nanosecond(), loc))
+	}
+
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Before(resp[j]) })
+	return resp
+}
+
+// monthDays menghasilkan tanggal dalam bulan yang cocok dengan BYMONTHDAY dan/atau BYDAY.
+// Tanpa keduanya, tanggal yang sama dengan DTSTART digunakan dan bulan yang tidak
+// memiliki tanggal tersebut dilewati.
+func (r *Rule) monthDays(first time.Time, dtstartDay int) []time.Time {
+	daysInMonth := first.AddDate(0, 1, -1).Day()
+
+	var resp []time.Time
+	for d := 1; d <= daysInMonth; d++ {
+		date := first.AddDate(0, 0, d-1)
+
+		switch {
+		case len(r.ByMonthDay) == 0 && len(r.ByDay) == 0:
+			if d != dtstartDay {
+				continue
+			}
+		case len(r.ByMonthDay) > 0 && !r.matchMonthDay(date):
+			continue
+		case len(r.ByDay) > 0 && !r.matchNthWeekday(date, daysInMonth):
+			continue
+		}
+		resp = append(resp, date)
+	}
+	return resp
+}
+
+// matchWeekday memeriksa BYDAY tanpa urutan
+func (r *Rule) matchWeekday(date time.Time) bool {
+	if len(r.ByDay) == 0 {
+		return true
+	}
+	for _, day := range r.ByDay {
+		if day.Weekday == date.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchNthWeekday memeriksa BYDAY beserta urutannya di dalam bulan, contoh -1FR untuk Jumat terakhir
+func (r *Rule) matchNthWeekday(date time.Time, daysInMonth int) bool {
+	for _, day := range r.ByDay {
+		if day.Weekday != date.Weekday() {
+			continue
+		}
+		if day.N == 0 {
+			return true
+		}
+
+		nth := (date.Day()-1)/7 + 1
+		nthFromEnd := -((daysInMonth-date.Day())/7 + 1)
+		if day.N == nth || day.N == nthFromEnd {
+			return true
+		}
+	}
+	return false
+}
+
+// matchMonthDay memeriksa BYMONTHDAY, nilai negatif dihitung dari akhir bulan
+func (r *Rule) matchMonthDay(date time.Time) bool {
+	if len(r.ByMonthDay) == 0 {
+		return true
+	}
+
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location()).Day()
+	for _, n := range r.ByMonthDay {
+		if n == date.Day() || daysInMonth+n+1 == date.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// matchMonth memeriksa BYMONTH
+func (r *Rule) matchMonth(date time.Time) bool {
+	if len(r.ByMonth) == 0 {
+		return true
+	}
+	for _, m := range r.ByMonth {
+		if m == date.Month() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// occurrences mengambil n kejadian pertama setelah dtstart
+func occurrences(t *testing.T, value string, dtstart time.Time, n int) []time.Time {
+	rule, err := Parse(value)
+	assert.Nil(t, err)
+
+	var resp []time.Time
+	after := dtstart
+	for i := 0; i < n; i++ {
+		next, ok := rule.Next(dtstart, after)
+		if !ok {
+			break
+		}
+		resp = append(resp, next)
+		after = next
+	}
+	return resp
+}
+
+func date(y int, m time.Month, d int, h int, loc *time.Location) time.Time {
+	return time.Date(y, m, d, h, 0, 0, 0, loc)
+}
+
+func TestParse(t *testing.T) {
+	rule, err := Parse("RRULE:FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR,2MO;BYMONTH=1,7;COUNT=5")
+
+	assert.Nil(t, err)
+	assert.Equal(t, Monthly, rule.Freq)
+	assert.Equal(t, 2, rule.Interval)
+	assert.Equal(t, 5, rule.Count)
+	assert.Equal(t, []ByDay{{N: -1, Weekday: time.Friday}, {N: 2, Weekday: time.Monday}}, rule.ByDay)
+	assert.Equal(t, []time.Month{time.January, time.July}, rule.ByMonth)
+}
+
+func TestParseUntil(t *testing.T) {
+	rule, err := Parse("FREQ=DAILY;UNTIL=20250131")
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC), *rule.Until)
+}
+
+func TestParseInUntilLocal(t *testing.T) {
+	loc := time.FixedZone("WIB", 7*60*60)
+
+	rule, err := ParseIn("FREQ=DAILY;UNTIL=20250131T090000", loc)
+	assert.Nil(t, err)
+	assert.True(t, time.Date(2025, 1, 31, 2, 0, 0, 0, time.UTC).Equal(*rule.Until))
+
+	rule, err = ParseIn("FREQ=DAILY;UNTIL=20250131", loc)
+	assert.Nil(t, err)
+	assert.True(t, time.Date(2025, 1, 31, 16, 59, 59, 0, time.UTC).Equal(*rule.Until))
+
+	// Akhiran Z selalu berarti UTC
+	rule, err = ParseIn("FREQ=DAILY;UNTIL=20250131T090000Z", loc)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC), *rule.Until)
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, value := range []string{
+		"",
+		"BYDAY=MO",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;FREQ=WEEKLY",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=DAILY;COUNT=2;UNTIL=20250101",
+		"FREQ=DAILY;BYSETPOS=1",
+		"FREQ=DAILY;COUNT",
+	} {
+		_, err := Parse(value)
+
+		assert.NotNil(t, err, "rule %q should be rejected", value)
+	}
+}
+
+func TestNextDaily(t *testing.T) {
+	dtstart := date(2025, 3, 30, 9, time.UTC)
+
+	got := occurrences(t, "FREQ=DAILY;INTERVAL=2", dtstart, 3)
+
+	assert.Equal(t, []time.Time{
+		date(2025, 4, 1, 9, time.UTC),
+		date(2025, 4, 3, 9, time.UTC),
+		date(2025, 4, 5, 9, time.UTC),
+	}, got)
+}
+
+func TestNextWeeklyByDay(t *testing.T) {
+	// 2025-03-05 adalah hari Rabu
+	dtstart := date(2025, 3, 5, 8, time.UTC)
+
+	got := occurrences(t, "FREQ=WEEKLY;BYDAY=MO,WE,FR", dtstart, 4)
+
+	assert.Equal(t, []time.Time{
+		date(2025, 3, 7, 8, time.UTC),
+		date(2025, 3, 10, 8, time.UTC),
+		date(2025, 3, 12, 8, time.UTC),
+		date(2025, 3, 14, 8, time.UTC),
+	}, got)
+}
+
+func TestNextWeeklyWithoutByDay(t *testing.T) {
+	dtstart := date(2025, 3, 5, 8, time.UTC)
+
+	got := occurrences(t, "FREQ=WEEKLY;INTERVAL=2", dtstart, 2)
+
+	assert.Equal(t, []time.Time{date(2025, 3, 19, 8, time.UTC), date(2025, 4, 2, 8, time.UTC)}, got)
+}
+
+func TestNextMonthlySkipsShortMonths(t *testing.T) {
+	dtstart := date(2025, 1, 31, 17, time.UTC)
+
+	got := occurrences(t, "FREQ=MONTHLY", dtstart, 3)
+
+	assert.Equal(t, []time.Time{
+		date(2025, 3, 31, 17, time.UTC),
+		date(2025, 5, 31, 17, time.UTC),
+		date(2025, 7, 31, 17, time.UTC),
+	}, got)
+}
+
+func TestNextMonthlyLastDay(t *testing.T) {
+	dtstart := date(2025, 1, 31, 17, time.UTC)
+
+	got := occurrences(t, "FREQ=MONTHLY;BYMONTHDAY=-1", dtstart, 3)
+
+	assert.Equal(t, []time.Time{
+		date(2025, 2, 28, 17, time.UTC),
+		date(2025, 3, 31, 17, time.UTC),
+		date(2025, 4, 30, 17, time.UTC),
+	}, got)
+}
+
+func TestNextMonthlyNthWeekday(t *testing.T) {
+	dtstart := date(2025, 1, 31, 16, time.UTC)
+
+	got := occurrences(t, "FREQ=MONTHLY;BYDAY=-1FR", dtstart, 3)
+
+	assert.Equal(t, []time.Time{
+		date(2025, 2, 28, 16, time.UTC),
+		date(2025, 3, 28, 16, time.UTC),
+		date(2025, 4, 25, 16, time.UTC),
+	}, got)
+}
+
+func TestNextYearlyByMonth(t *testing.T) {
+	dtstart := date(2025, 1, 15, 10, time.UTC)
+
+	got := occurrences(t, "FREQ=YEARLY;BYMONTH=1,7", dtstart, 3)
+
+	assert.Equal(t, []time.Time{
+		date(2025, 7, 15, 10, time.UTC),
+		date(2026, 1, 15, 10, time.UTC),
+		date(2026, 7, 15, 10, time.UTC),
+	}, got)
+}
+
+func TestNextCount(t *testing.T) {
+	dtstart := date(2025, 3, 1, 9, time.UTC)
+
+	got := occurrences(t, "FREQ=DAILY;COUNT=3", dtstart, 10)
+
+	// DTSTART adalah kejadian pertama sehingga hanya tersisa dua kejadian
+	assert.Equal(t, []time.Time{date(2025, 3, 2, 9, time.UTC), date(2025, 3, 3, 9, time.UTC)}, got)
+}
+
+func TestNextUntil(t *testing.T) {
+	dtstart := date(2025, 3, 1, 9, time.UTC)
+
+	got := occurrences(t, "FREQ=DAILY;UNTIL=20250303T090000Z", dtstart, 10)
+
+	assert.Equal(t, []time.Time{date(2025, 3, 2, 9, time.UTC), date(2025, 3, 3, 9, time.UTC)}, got)
+}
+
+func TestNextAfterLateFinish(t *testing.T) {
+	rule, _ := Parse("FREQ=WEEKLY;BYDAY=MO")
+	dtstart := date(2025, 3, 3, 9, time.UTC)
+
+	next, ok := rule.Next(dtstart, date(2025, 3, 20, 12, time.UTC))
+
+	assert.True(t, ok)
+	assert.Equal(t, date(2025, 3, 24, 9, time.UTC), next)
+}
+
+func TestNextKeepsLocalTimeAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("time zone database not available")
+	}
+
+	// Daylight saving di New York dimulai 9 Maret 2025
+	dtstart := date(2025, 3, 7, 9, loc)
+
+	got := occurrences(t, "FREQ=WEEKLY", dtstart, 1)
+
+	assert.Equal(t, date(2025, 3, 14, 9, loc), got[0])
+	assert.Equal(t, 13, got[0].UTC().Hour())
+	assert.Equal(t, 14, dtstart.UTC().Hour())
+}
+
+func TestNextNeverMatches(t *testing.T) {
+	rule, _ := Parse("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	dtstart := date(2025, 1, 1, 9, time.UTC)
+
+	_, ok := rule.Next(dtstart, dtstart)
+
+	assert.False(t, ok)
+}
@@ -0,0 +1,83 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// JobFunc adalah pekerjaan yang dijalankan secara berkala oleh scheduler
+type JobFunc func() error
+
+type job struct {
+	name     string
+	interval time.Duration
+	fn       JobFunc
+}
+
+// Scheduler menjalankan pekerjaan berkala di goroutine terpisah
+// dan menunggu semuanya selesai ketika dihentikan.
+type Scheduler struct {
+	logger *logrus.Logger
+	jobs   []job
+	wg     sync.WaitGroup
+	cancel context.CancelFunc
+}
+
+// New membuat instance baru dari Scheduler
+func New(logger *logrus.Logger) *Scheduler {
+	return &Scheduler{logger: logger}
+}
+
+// Every mendaftarkan pekerjaan yang dijalankan setiap interval.
+// Pekerjaan dengan interval <= 0 dianggap nonaktif dan tidak didaftarkan.
+func (s *Scheduler) Every(name string, interval time.Duration, fn JobFunc) {
+	if interval <= 0 {
+		s.logger.Printf("scheduler job [%s] is disabled", name)
+		return
+	}
+
+	s.jobs = append(s.jobs, job{name: name, interval: interval, fn: fn})
+}
+
+// Start menjalankan semua pekerjaan yang sudah didaftarkan
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.run(ctx, j)
+	}
+}
+
+// Stop menghentikan scheduler dan menunggu pekerjaan yang sedang berjalan selesai
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+
+	s.logger.Println("scheduler exiting")
+}
+
+func (s *Scheduler) run(ctx context.Context, j job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	s.logger.Printf("scheduler job [%s] started, every %s", j.name, j.interval)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := j.fn(); err != nil {
+				s.logger.Errorf("scheduler job [%s] failed: %s", j.name, err)
+			}
+		}
+	}
+}
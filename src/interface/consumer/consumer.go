@@ -0,0 +1,56 @@
+package consumer
+
+import (
+	usecases "todo_list/src/app/usecases"
+	natsConsumer "todo_list/src/infra/broker/nats/consumer"
+	Const "todo_list/src/infra/constants"
+
+	taskConsumer "todo_list/src/interface/consumer/handler/task"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Consumer menyimpan dependency untuk memproses pesan dari NATS
+type Consumer struct {
+	natsConsumer.ConsumerInterface
+	logger   *logrus.Logger
+	subjects []string
+}
+
+// New membuat consumer dan mendaftarkan handler untuk semua subject
+func New(
+	c natsConsumer.ConsumerInterface,
+	logger *logrus.Logger,
+	useCases usecases.AllUseCases,
+) (*Consumer, error) {
+	tc := taskConsumer.NewTaskConsumer(useCases.TaskUC)
+
+	// daftar subject dan handler-nya
+	subjects := map[string]natsConsumer.HandlerFunc{
+		Const.ADD_TASK:    tc.AddTask,
+		Const.FINISH_TASK: tc.FinishTask,
+	}
+
+	consumer := &Consumer{c, logger, nil}
+	for subject, handler := range subjects {
+		if err := c.Subscribe(subject, handler); err != nil {
+			c.Close()
+			return nil, err
+		}
+		consumer.subjects = append(consumer.subjects, subject)
+	}
+
+	return consumer, nil
+}
+
+// Stop menghentikan semua subscription dan mencatat jumlah pesan yang gagal
+func (c *Consumer) Stop() {
+	for _, subject := range c.subjects {
+		if total := c.Failures(subject); total > 0 {
+			c.logger.Warnf("consumer [%s] failed to process %d message(s)", subject, total)
+		}
+	}
+
+	c.Close()
+	c.logger.Println("consumer exiting")
+}
@@ -0,0 +1,69 @@
+package task
+
+import (
+	"encoding/json"
+	"fmt"
+	dto "todo_list/src/app/dto/task"
+	usecases "todo_list/src/app/usecases/task"
+)
+
+// TaskConsumerInterface mendefinisikan kontrak untuk handler pesan task dari NATS
+type TaskConsumerInterface interface {
+	AddTask(data []byte) error
+	FinishTask(data []byte) error
+}
+
+// TaskConsumer adalah implementasi dari TaskConsumerInterface
+type TaskConsumer struct {
+	usecase usecases.TaskUCInterface // Menghubungkan ke layer use case
+}
+
+// NewTaskConsumer membuat instance baru dari TaskConsumer
+func NewTaskConsumer(u usecases.TaskUCInterface) TaskConsumerInterface {
+	return &TaskConsumer{
+		usecase: u,
+	}
+}
+
+// AddTask memproses pesan addtask dan menyimpan task baru
+func (c *TaskConsumer) AddTask(data []byte) error {
+	req := dto.CreateTaskReqDTO{}
+
+	// Decode payload pesan ke DTO
+	if err := json.Unmarshal(data, &req); err != nil {
+		return err
+	}
+
+	// Validasi ulang karena pesan bisa datang dari publisher lain
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	return withRequestID(req.Audit, c.usecase.SaveTask(&req))
+}
+
+// FinishTask memproses pesan finishtask dan menyimpan status task
+func (c *TaskConsumer) FinishTask(data []byte) error {
+	req := dto.FinishtTaskReqDTO{}
+
+	// Decode payload pesan ke DTO
+	if err := json.Unmarshal(data, &req); err != nil {
+		return err
+	}
+
+	// Pesan tanpa user_id tidak bisa diverifikasi kepemilikannya
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
+	return withRequestID(req.Audit, c.usecase.SaveFinishTask(&req))
+}
+
+// withRequestID menambahkan X-Request-ID asal pesan ke error
+// agar kegagalan di consumer bisa dicocokkan dengan request HTTP-nya
+func withRequestID(audit dto.AuditDTO, err error) error {
+	if err == nil || audit.RequestID == "" {
+		return err
+	}
+	return fmt.Errorf("request %s: %w", audit.RequestID, err)
+}
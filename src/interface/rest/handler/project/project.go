@@ -0,0 +1,323 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	dto "todo_list/src/app/dto/project"
+	usecases "todo_list/src/app/usecases/project"
+	common_error "todo_list/src/infra/errors"
+	"todo_list/src/infra/helper"
+	"todo_list/src/interface/rest/response"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt"
+)
+
+// ProjectHandlerInterface mendefinisikan kontrak untuk handler project
+type ProjectHandlerInterface interface {
+	GetProjectList(w http.ResponseWriter, r *http.Request)
+	GetProject(w http.ResponseWriter, r *http.Request)
+	AddProject(w http.ResponseWriter, r *http.Request)
+	UpdateProject(w http.ResponseWriter, r *http.Request)
+	DeleteProject(w http.ResponseWriter, r *http.Request)
+	ReorderProject(w http.ResponseWriter, r *http.Request)
+	ShareProject(w http.ResponseWriter, r *http.Request)
+	GetProjectShares(w http.ResponseWriter, r *http.Request)
+	RemoveProjectShare(w http.ResponseWriter, r *http.Request)
+}
+
+// ProjectHandler adalah implementasi dari ProjectHandlerInterface
+type ProjectHandler struct {
+	response response.IResponseClient    // Untuk menangani response HTTP
+	usecase  usecases.ProjectUCInterface // Menghubungkan ke layer use case
+}
+
+// NewProjectHandler membuat instance baru dari ProjectHandler
+func NewProjectHandler(r response.IResponseClient, h usecases.ProjectUCInterface) ProjectHandlerInterface {
+	return &ProjectHandler{
+		response: r,
+		usecase:  h,
+	}
+}
+
+// extractBearerToken mengekstrak token dari header Authorization
+func (h *ProjectHandler) extractBearerToken(r *http.Request) (string, error) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return "", errors.New("missing authorization token")
+	}
+
+	// Pastikan format header "Authorization" adalah "Bearer <token>"
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return "", errors.New("invalid authorization header format")
+	}
+
+	return parts[1], nil // Mengembalikan token tanpa kata "Bearer"
+}
+
+// authorize memverifikasi token JWT dan mengembalikan klaim milik user
+func (h *ProjectHandler) authorize(r *http.Request) (*helper.TokenClaims, error) {
+	// Ekstrak token dari header Authorization
+	tokenString, err := h.extractBearerToken(r)
+	if err != nil {
+		return nil, common_error.NewError(common_error.UNAUTHORIZED, err)
+	}
+
+	// Verifikasi token JWT
+	dataClaims, err := helper.VerifyToken(tokenString)
+	if err != nil {
+		// Tangani error jika token tidak valid atau kadaluarsa
+		if err == jwt.ErrSignatureInvalid || strings.Contains(err.Error(), "token is expired") {
+			return nil, common_error.NewError(common_error.UNAUTHORIZED, errors.New("token expired"))
+		}
+		return nil, common_error.NewError(common_error.UNAUTHORIZED, err)
+	}
+
+	return dataClaims, nil
+}
+
+// projectID mengambil id project dari parameter URL
+func (h *ProjectHandler) projectID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid project id"))
+	}
+	return id, nil
+}
+
+// GetProjectList menangani request untuk mendapatkan daftar project pengguna
+func (h *ProjectHandler) GetProjectList(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Project yang diarsipkan hanya ditampilkan jika diminta
+	getDTO := dto.GetProjectListReqDTO{UserID: dataClaims.UserID}
+	if v := r.URL.Query().Get("include_archived"); v != "" {
+		getDTO.IncludeArchived, err = strconv.ParseBool(v)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("include_archived: must be a boolean")))
+			return
+		}
+	}
+
+	// Panggil use case untuk mendapatkan daftar project
+	resp, err := h.usecase.GetProjectList(&getDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan daftar project
+	h.response.JSON(
+		w,
+		"get project sukses",
+		resp,
+		nil,
+	)
+}
+
+// GetProject menangani request untuk mendapatkan satu project beserta jumlah task-nya
+func (h *ProjectHandler) GetProject(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id project dari URL
+	id, err := h.projectID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mendapatkan project
+	resp, err := h.usecase.GetProject(&dto.GetProjectReqDTO{ID: id, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data project
+	h.response.JSON(
+		w,
+		"get project sukses",
+		resp,
+		nil,
+	)
+}
+
+// AddProject menangani request untuk membuat project baru
+func (h *ProjectHandler) AddProject(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	postDTO := dto.CreateProjectReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi input data project
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menyimpan project
+	resp, err := h.usecase.AddProject(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data project
+	h.response.JSON(
+		w,
+		"tambah project sukses",
+		resp,
+		nil,
+	)
+}
+
+// UpdateProject menangani request untuk mengubah atau mengarsipkan project
+func (h *ProjectHandler) UpdateProject(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id project dari URL
+	id, err := h.projectID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	putDTO := dto.UpdateProjectReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	putDTO.ID = id
+	putDTO.UserID = dataClaims.UserID
+
+	// Validasi input data project
+	err = putDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk memperbarui project
+	resp, err := h.usecase.UpdateProject(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data project terbaru
+	h.response.JSON(
+		w,
+		"update project sukses",
+		resp,
+		nil,
+	)
+}
+
+// DeleteProject menangani request untuk menghapus project
+func (h *ProjectHandler) DeleteProject(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id project dari URL
+	id, err := h.projectID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk menghapus project
+	err = h.usecase.DeleteProject(&dto.DeleteProjectReqDTO{ID: id, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"hapus project sukses",
+		nil,
+		nil,
+	)
+}
+
+// ReorderProject menangani request untuk mengubah urutan project
+func (h *ProjectHandler) ReorderProject(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	putDTO := dto.ReorderProjectReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	putDTO.UserID = dataClaims.UserID
+
+	// Validasi urutan project
+	err = putDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menyimpan urutan baru
+	err = h.usecase.ReorderProject(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"urutkan project sukses",
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,234 @@
+package tag
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	dto "todo_list/src/app/dto/tag"
+	usecases "todo_list/src/app/usecases/tag"
+	common_error "todo_list/src/infra/errors"
+	"todo_list/src/infra/helper"
+	"todo_list/src/interface/rest/response"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/golang-jwt/jwt"
+)
+
+// TagHandlerInterface mendefinisikan kontrak untuk handler tag
+type TagHandlerInterface interface {
+	GetTagList(w http.ResponseWriter, r *http.Request)
+	AddTag(w http.ResponseWriter, r *http.Request)
+	UpdateTag(w http.ResponseWriter, r *http.Request)
+	DeleteTag(w http.ResponseWriter, r *http.Request)
+}
+
+// TagHandler adalah implementasi dari TagHandlerInterface
+type TagHandler struct {
+	response response.IResponseClient // Untuk menangani response HTTP
+	usecase  usecases.TagUCInterface  // Menghubungkan ke layer use case
+}
+
+// NewTagHandler membuat instance baru dari TagHandler
+func NewTagHandler(r response.IResponseClient, h usecases.TagUCInterface) TagHandlerInterface {
+	return &TagHandler{
+		response: r,
+		usecase:  h,
+	}
+}
+
+// extractBearerToken mengekstrak token dari header Authorization
+func (h *TagHandler) extractBearerToken(r *http.Request) (string, error) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return "", errors.New("missing authorization token")
+	}
+
+	// Pastikan format header "Authorization" adalah "Bearer <token>"
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+		return "", errors.New("invalid authorization header format")
+	}
+
+	return parts[1], nil // Mengembalikan token tanpa kata "Bearer"
+}
+
+// authorize memverifikasi token JWT dan mengembalikan klaim milik user
+func (h *TagHandler) authorize(r *http.Request) (*helper.TokenClaims, error) {
+	// Ekstrak token dari header Authorization
+	tokenString, err := h.extractBearerToken(r)
+	if err != nil {
+		return nil, common_error.NewError(common_error.UNAUTHORIZED, err)
+	}
+
+	// Verifikasi token JWT
+	dataClaims, err := helper.VerifyToken(tokenString)
+	if err != nil {
+		// Tangani error jika token tidak valid atau kadaluarsa
+		if err == jwt.ErrSignatureInvalid || strings.Contains(err.Error(), "token is expired") {
+			return nil, common_error.NewError(common_error.UNAUTHORIZED, errors.New("token expired"))
+		}
+		return nil, common_error.NewError(common_error.UNAUTHORIZED, err)
+	}
+
+	return dataClaims, nil
+}
+
+// tagID mengambil id tag dari parameter URL
+func (h *TagHandler) tagID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid tag id"))
+	}
+	return id, nil
+}
+
+// GetTagList menangani request untuk mendapatkan daftar tag pengguna
+func (h *TagHandler) GetTagList(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mendapatkan daftar tag
+	resp, err := h.usecase.GetTagList(&dto.GetTagListReqDTO{UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan daftar tag
+	h.response.JSON(
+		w,
+		"get tag sukses",
+		resp,
+		nil,
+	)
+}
+
+// AddTag menangani request untuk membuat tag baru
+func (h *TagHandler) AddTag(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	postDTO := dto.CreateTagReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi input data tag
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menyimpan tag
+	resp, err := h.usecase.AddTag(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data tag
+	h.response.JSON(
+		w,
+		"tambah tag sukses",
+		resp,
+		nil,
+	)
+}
+
+// UpdateTag menangani request untuk mengubah tag
+func (h *TagHandler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id tag dari URL
+	id, err := h.tagID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	putDTO := dto.UpdateTagReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	putDTO.ID = id
+	putDTO.UserID = dataClaims.UserID
+
+	// Validasi input data tag
+	err = putDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk memperbarui tag
+	resp, err := h.usecase.UpdateTag(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data tag terbaru
+	h.response.JSON(
+		w,
+		"update tag sukses",
+		resp,
+		nil,
+	)
+}
+
+// DeleteTag menangani request untuk menghapus tag
+func (h *TagHandler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id tag dari URL
+	id, err := h.tagID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk menghapus tag
+	err = h.usecase.DeleteTag(&dto.DeleteTagReqDTO{ID: id, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"hapus tag sukses",
+		nil,
+		nil,
+	)
+}
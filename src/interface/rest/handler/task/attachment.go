@@ -0,0 +1,221 @@
+package task
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strconv"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// attachmentFormField adalah nama field multipart yang berisi file lampiran
+const attachmentFormField = "file"
+
+// attachmentID mengambil id lampiran dari URL
+func (h *TaskHandler) attachmentID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "attachmentId"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid attachment id"))
+	}
+	return id, nil
+}
+
+// AddAttachment menangani request multipart untuk mengunggah lampiran ke task.
+// File dibaca langsung dari body request tanpa disimpan dulu di memori atau disk sementara.
+func (h *TaskHandler) AddAttachment(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	reader, err := r.MultipartReader()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Cari bagian multipart yang berisi file, field lain diabaikan
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("file: cannot be blank")))
+			return
+		}
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+			return
+		}
+		if part.FormName() != attachmentFormField || part.FileName() == "" {
+			part.Close()
+			continue
+		}
+
+		// Content-Type ditentukan dari isi file, bukan dari header yang dikirim client
+		file := bufio.NewReader(part)
+		head, _ := file.Peek(512)
+
+		postDTO := dto.AddAttachmentReqDTO{
+			TaskID:      taskID,
+			UserID:      dataClaims.UserID,
+			FileName:    part.FileName(),
+			ContentType: http.DetectContentType(head),
+			File:        file,
+		}
+
+		// Validasi data lampiran
+		err = postDTO.Validate()
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+			return
+		}
+
+		// Panggil use case untuk menyimpan lampiran
+		resp, err := h.usecase.AddAttachment(&postDTO)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+			return
+		}
+
+		// Beri response sukses dengan data lampiran
+		h.response.JSON(
+			w,
+			"add attachment sukses",
+			resp,
+			nil,
+		)
+		return
+	}
+}
+
+// GetAttachmentList menangani request untuk mendapatkan daftar lampiran task
+func (h *TaskHandler) GetAttachmentList(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mendapatkan daftar lampiran
+	resp, err := h.usecase.GetAttachmentList(&dto.GetAttachmentListReqDTO{TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan daftar lampiran
+	h.response.JSON(
+		w,
+		"get attachment sukses",
+		resp,
+		nil,
+	)
+}
+
+// DownloadAttachment menangani request untuk mengunduh isi lampiran secara streaming
+func (h *TaskHandler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dan id lampiran dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	attachmentID, err := h.attachmentID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk membuka lampiran
+	resp, file, err := h.usecase.GetAttachment(&dto.GetAttachmentReqDTO{ID: attachmentID, TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+	defer file.Close()
+
+	// Lampiran selalu diunduh sebagai file agar tidak dijalankan oleh browser
+	disposition := mime.FormatMediaType("attachment", map[string]string{"filename": resp.FileName})
+	if disposition == "" {
+		disposition = "attachment"
+	}
+
+	w.Header().Set("Content-Type", resp.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(resp.Size, 10))
+	w.Header().Set("Content-Disposition", disposition)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	// Header sudah terkirim, sehingga error saat streaming hanya bisa dicatat
+	if _, err := io.Copy(w, file); err != nil {
+		log.Println("Failed to stream attachment:", err)
+	}
+}
+
+// DeleteAttachment menangani request untuk menghapus lampiran task
+func (h *TaskHandler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dan id lampiran dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	attachmentID, err := h.attachmentID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk menghapus lampiran
+	err = h.usecase.DeleteAttachment(&dto.DeleteAttachmentReqDTO{ID: attachmentID, TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"delete attachment sukses",
+		nil,
+		nil,
+	)
+}
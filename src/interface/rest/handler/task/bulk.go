@@ -0,0 +1,53 @@
+package task
+
+import (
+	"encoding/json"
+	"net/http"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// BulkTask menangani request untuk menjalankan banyak operasi task sekaligus
+func (h *TaskHandler) BulkTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO untuk bulk task
+	postDTO := dto.BulkTaskReqDTO{}
+
+	// Decode body request ke DTO
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.UserID = dataClaims.UserID
+	postDTO.Audit = h.audit(r, dataClaims.UserID)
+
+	// Validasi mode dan daftar operasi
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menjalankan operasi
+	resp, err := h.usecase.BulkTask(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response dengan hasil per operasi
+	h.response.JSON(
+		w,
+		"bulk task selesai",
+		resp,
+		nil,
+	)
+}
@@ -0,0 +1,243 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// itemID mengambil id item checklist dari parameter URL
+func (h *TaskHandler) itemID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "itemId"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid item id"))
+	}
+	return id, nil
+}
+
+// GetChecklist menangani request untuk mendapatkan checklist sebuah task
+func (h *TaskHandler) GetChecklist(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mendapatkan checklist
+	resp, err := h.usecase.GetChecklist(&dto.GetChecklistReqDTO{TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan daftar item checklist
+	h.response.JSON(
+		w,
+		"get checklist sukses",
+		resp,
+		nil,
+	)
+}
+
+// AddChecklistItem menangani request untuk menambahkan item checklist ke task
+func (h *TaskHandler) AddChecklistItem(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	postDTO := dto.AddChecklistItemReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.TaskID = taskID
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi input data item checklist
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menambahkan item checklist
+	resp, err := h.usecase.AddChecklistItem(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan item checklist baru
+	h.response.JSON(
+		w,
+		"tambah item checklist sukses",
+		resp,
+		nil,
+	)
+}
+
+// CheckChecklistItem menangani request untuk menandai item checklist selesai
+func (h *TaskHandler) CheckChecklistItem(w http.ResponseWriter, r *http.Request) {
+	h.setChecklistItemDone(w, r, true)
+}
+
+// UncheckChecklistItem menangani request untuk menandai item checklist belum selesai
+func (h *TaskHandler) UncheckChecklistItem(w http.ResponseWriter, r *http.Request) {
+	h.setChecklistItemDone(w, r, false)
+}
+
+// setChecklistItemDone mengubah status selesai item checklist
+func (h *TaskHandler) setChecklistItemDone(w http.ResponseWriter, r *http.Request, done bool) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dan id item dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+	itemID, err := h.itemID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mengubah status item checklist
+	_, err = h.usecase.CheckChecklistItem(&dto.CheckChecklistItemReqDTO{
+		ID:     itemID,
+		TaskID: taskID,
+		UserID: dataClaims.UserID,
+		Done:   done,
+		Audit:  h.audit(r, dataClaims.UserID),
+	})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"update item checklist sukses",
+		nil,
+		nil,
+	)
+}
+
+// ReorderChecklist menangani request untuk mengubah urutan item checklist
+func (h *TaskHandler) ReorderChecklist(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	putDTO := dto.ReorderChecklistReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	putDTO.TaskID = taskID
+	putDTO.UserID = dataClaims.UserID
+
+	// Validasi urutan item checklist
+	err = putDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menyimpan urutan baru
+	err = h.usecase.ReorderChecklist(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"urutkan checklist sukses",
+		nil,
+		nil,
+	)
+}
+
+// DeleteChecklistItem menangani request untuk menghapus item checklist
+func (h *TaskHandler) DeleteChecklistItem(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dan id item dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+	itemID, err := h.itemID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk menghapus item checklist
+	err = h.usecase.DeleteChecklistItem(&dto.DeleteChecklistItemReqDTO{ID: itemID, TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"hapus item checklist sukses",
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,228 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// commentID mengambil id komentar dari URL
+func (h *TaskHandler) commentID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "commentId"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid comment id"))
+	}
+	return id, nil
+}
+
+// AddComment menangani request untuk menambahkan komentar ke task
+func (h *TaskHandler) AddComment(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	postDTO := dto.AddCommentReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.TaskID = taskID
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi isi komentar
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menyimpan komentar
+	resp, err := h.usecase.AddComment(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data komentar
+	h.response.JSON(
+		w,
+		"add comment sukses",
+		resp,
+		nil,
+	)
+}
+
+// GetCommentList menangani request untuk mendapatkan komentar task per halaman
+func (h *TaskHandler) GetCommentList(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO paginasi dari query parameter
+	query := r.URL.Query()
+	getDTO := dto.GetCommentListReqDTO{
+		TaskID: taskID,
+		UserID: dataClaims.UserID,
+	}
+
+	if v := query.Get("page"); v != "" {
+		getDTO.Page, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("page: must be a number")))
+			return
+		}
+	}
+
+	if v := query.Get("per_page"); v != "" {
+		getDTO.PerPage, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("per_page: must be a number")))
+			return
+		}
+	}
+
+	// Validasi paginasi
+	err = getDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk mendapatkan komentar
+	resp, total, err := h.usecase.GetCommentList(&getDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan daftar komentar dan informasi paginasi
+	h.response.JSON(
+		w,
+		"get comment sukses",
+		resp,
+		h.response.BuildMeta(int(getDTO.Page), int(getDTO.PerPage), total),
+	)
+}
+
+// UpdateComment menangani request untuk mengubah komentar milik user
+func (h *TaskHandler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dan id komentar dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	commentID, err := h.commentID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	putDTO := dto.UpdateCommentReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	putDTO.ID = commentID
+	putDTO.TaskID = taskID
+	putDTO.UserID = dataClaims.UserID
+
+	// Validasi isi komentar
+	err = putDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk memperbarui komentar
+	resp, err := h.usecase.UpdateComment(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data komentar terbaru
+	h.response.JSON(
+		w,
+		"update comment sukses",
+		resp,
+		nil,
+	)
+}
+
+// DeleteComment menangani request untuk menghapus komentar milik user
+func (h *TaskHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dan id komentar dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	commentID, err := h.commentID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk menghapus komentar
+	err = h.usecase.DeleteComment(&dto.DeleteCommentReqDTO{ID: commentID, TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"delete comment sukses",
+		nil,
+		nil,
+	)
+}
@@ -0,0 +1,105 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// AddDependency menangani request untuk menandai task bergantung pada task lain
+func (h *TaskHandler) AddDependency(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	postDTO := dto.AddDependencyReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.TaskID = taskID
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi task yang ditunggu
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk menyimpan ketergantungan
+	resp, err := h.usecase.AddDependency(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data task terbaru
+	h.response.JSON(
+		w,
+		"add dependency sukses",
+		resp,
+		nil,
+	)
+}
+
+// RemoveDependency menangani request untuk menghapus ketergantungan task
+func (h *TaskHandler) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task yang ditunggu dari URL
+	dependsOnID, err := strconv.ParseInt(chi.URLParam(r, "dependsOnId"), 10, 64)
+	if err != nil || dependsOnID <= 0 {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid dependency id")))
+		return
+	}
+
+	// Panggil use case untuk menghapus ketergantungan
+	resp, err := h.usecase.RemoveDependency(&dto.RemoveDependencyReqDTO{
+		TaskID:      taskID,
+		UserID:      dataClaims.UserID,
+		DependsOnID: dependsOnID,
+	})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data task terbaru
+	h.response.JSON(
+		w,
+		"remove dependency sukses",
+		resp,
+		nil,
+	)
+}
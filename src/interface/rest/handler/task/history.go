@@ -0,0 +1,71 @@
+package task
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// GetTaskHistory menangani request untuk mendapatkan riwayat perubahan task per halaman
+func (h *TaskHandler) GetTaskHistory(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO paginasi dari query parameter
+	query := r.URL.Query()
+	getDTO := dto.GetTaskHistoryReqDTO{
+		TaskID: taskID,
+		UserID: dataClaims.UserID,
+	}
+
+	if v := query.Get("page"); v != "" {
+		getDTO.Page, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("page: must be a number")))
+			return
+		}
+	}
+
+	if v := query.Get("per_page"); v != "" {
+		getDTO.PerPage, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("per_page: must be a number")))
+			return
+		}
+	}
+
+	// Validasi paginasi
+	err = getDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk mendapatkan riwayat task
+	resp, total, err := h.usecase.GetTaskHistory(&getDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan riwayat task dan informasi paginasi
+	h.response.JSON(
+		w,
+		"get task history sukses",
+		resp,
+		h.response.BuildMeta(int(getDTO.Page), int(getDTO.PerPage), total),
+	)
+}
@@ -0,0 +1,60 @@
+package task
+
+import (
+	"encoding/json"
+	"net/http"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// MoveTask menangani request untuk memindahkan task pada urutan manual
+func (h *TaskHandler) MoveTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO untuk memindahkan task
+	postDTO := dto.MoveTaskReqDTO{}
+
+	// Decode body request ke DTO
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.ID = id
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi task tetangga
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk memindahkan task
+	resp, err := h.usecase.MoveTask(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data task terbaru
+	h.response.JSON(
+		w,
+		"move task sukses",
+		resp,
+		nil,
+	)
+}
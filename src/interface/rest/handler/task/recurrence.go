@@ -0,0 +1,122 @@
+package task
+
+import (
+	"encoding/json"
+	"net/http"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// GetRecurrence menangani request untuk mendapatkan seri berulang dari sebuah task
+func (h *TaskHandler) GetRecurrence(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mendapatkan seri berulang
+	resp, err := h.usecase.GetRecurrence(&dto.GetRecurrenceReqDTO{TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data seri berulang
+	h.response.JSON(
+		w,
+		"get recurrence sukses",
+		resp,
+		nil,
+	)
+}
+
+// UpdateRecurrence menangani request untuk mengubah aturan atau template seri berulang
+func (h *TaskHandler) UpdateRecurrence(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	putDTO := dto.UpdateRecurrenceReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	putDTO.TaskID = taskID
+	putDTO.UserID = dataClaims.UserID
+
+	// Validasi aturan dan template seri berulang
+	err = putDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk memperbarui seri berulang
+	resp, err := h.usecase.UpdateRecurrence(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data seri berulang terbaru
+	h.response.JSON(
+		w,
+		"update recurrence sukses",
+		resp,
+		nil,
+	)
+}
+
+// StopRecurrence menangani request untuk menghentikan seri berulang
+func (h *TaskHandler) StopRecurrence(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk menghentikan seri berulang
+	err = h.usecase.StopRecurrence(&dto.StopRecurrenceReqDTO{TaskID: taskID, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"recurrence dihentikan",
+		nil,
+		nil,
+	)
+}
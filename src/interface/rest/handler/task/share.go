@@ -0,0 +1,142 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	dto "todo_list/src/app/dto/share"
+	common_error "todo_list/src/infra/errors"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// shareUserID mengambil id kolaborator dari URL
+func (h *TaskHandler) shareUserID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "userId"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid user id"))
+	}
+	return id, nil
+}
+
+// ShareTask menangani request untuk membagikan task ke user lain berdasarkan email
+func (h *TaskHandler) ShareTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO
+	postDTO := dto.ShareReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.ID = id
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi email dan permission
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk membagikan task
+	resp, err := h.usecase.ShareTask(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data kolaborator
+	h.response.JSON(
+		w,
+		"share task sukses",
+		resp,
+		nil,
+	)
+}
+
+// GetTaskShares menangani request untuk mendapatkan daftar kolaborator task
+func (h *TaskHandler) GetTaskShares(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mendapatkan daftar kolaborator
+	resp, err := h.usecase.GetTaskShares(&dto.GetShareListReqDTO{ID: id, UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan daftar kolaborator
+	h.response.JSON(
+		w,
+		"get task shares sukses",
+		resp,
+		nil,
+	)
+}
+
+// RemoveTaskShare menangani request untuk mencabut akses kolaborator dari task
+func (h *TaskHandler) RemoveTaskShare(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id kolaborator dari URL
+	shareUserID, err := h.shareUserID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mencabut akses kolaborator
+	err = h.usecase.RemoveTaskShare(&dto.RemoveShareReqDTO{ID: id, UserID: dataClaims.UserID, ShareUserID: shareUserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"akses task dicabut",
+		nil,
+		nil,
+	)
+}
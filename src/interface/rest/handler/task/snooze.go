@@ -0,0 +1,61 @@
+package task
+
+import (
+	"encoding/json"
+	"net/http"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// SnoozeTask menangani request untuk mengundur expires_at task
+func (h *TaskHandler) SnoozeTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO untuk snooze task
+	postDTO := dto.SnoozeTaskReqDTO{}
+
+	// Decode body request ke DTO
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.ID = id
+	postDTO.UserID = dataClaims.UserID
+	postDTO.Audit = h.audit(r, dataClaims.UserID)
+
+	// Validasi preset atau waktu snooze
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk mengundur task
+	resp, err := h.usecase.SnoozeTask(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data task terbaru
+	h.response.JSON(
+		w,
+		"snooze task sukses",
+		resp,
+		nil,
+	)
+}
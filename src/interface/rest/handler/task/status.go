@@ -0,0 +1,71 @@
+package task
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// StartTask menangani request untuk mulai mengerjakan task
+func (h *TaskHandler) StartTask(w http.ResponseWriter, r *http.Request) {
+	h.transitionTask(w, r, &dto.TransitionTaskReqDTO{Action: dto.TaskEventStart}, "start task sukses")
+}
+
+// CancelTask menangani request untuk membatalkan task
+func (h *TaskHandler) CancelTask(w http.ResponseWriter, r *http.Request) {
+	h.transitionTask(w, r, &dto.TransitionTaskReqDTO{Action: dto.TaskEventCancel}, "cancel task sukses")
+}
+
+// ReopenTask menangani request untuk membuka kembali task yang sudah selesai.
+// Body boleh kosong, atau berisi expires_at baru jika kadaluarsa task sudah lewat.
+func (h *TaskHandler) ReopenTask(w http.ResponseWriter, r *http.Request) {
+	postDTO := dto.TransitionTaskReqDTO{}
+
+	// Decode body request ke DTO, body kosong berarti expires_at tidak diubah
+	err := json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil && err != io.EOF {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.Action = dto.TaskEventReopen
+	h.transitionTask(w, r, &postDTO, "reopen task sukses")
+}
+
+// transitionTask menjalankan perpindahan status task sesuai aksi dari URL
+func (h *TaskHandler) transitionTask(w http.ResponseWriter, r *http.Request, req *dto.TransitionTaskReqDTO, message string) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	req.ID = id
+	req.UserID = dataClaims.UserID
+	req.Audit = h.audit(r, dataClaims.UserID)
+
+	// Panggil use case untuk memindahkan status task
+	resp, err := h.usecase.TransitionTask(req)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data task terbaru
+	h.response.JSON(
+		w,
+		message,
+		resp,
+		nil,
+	)
+}
@@ -4,13 +4,17 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 	dto "todo_list/src/app/dto/task"
 	usecases "todo_list/src/app/usecases/task"
 	common_error "todo_list/src/infra/errors"
 	"todo_list/src/infra/helper"
 	"todo_list/src/interface/rest/response"
 
+	"github.com/go-chi/chi/middleware"
+	"github.com/go-chi/chi/v5"
 	"github.com/golang-jwt/jwt"
 )
 
@@ -18,7 +22,45 @@ import (
 type TaskHandlerInterface interface {
 	AddTask(w http.ResponseWriter, r *http.Request)
 	FinishTask(w http.ResponseWriter, r *http.Request)
+	UpdateTask(w http.ResponseWriter, r *http.Request)
 	GetTaskList(w http.ResponseWriter, r *http.Request)
+	SearchTask(w http.ResponseWriter, r *http.Request)
+	DeleteTask(w http.ResponseWriter, r *http.Request)
+	RestoreTask(w http.ResponseWriter, r *http.Request)
+	GetTrashList(w http.ResponseWriter, r *http.Request)
+	GetProjectTaskList(w http.ResponseWriter, r *http.Request)
+	GetChecklist(w http.ResponseWriter, r *http.Request)
+	AddChecklistItem(w http.ResponseWriter, r *http.Request)
+	CheckChecklistItem(w http.ResponseWriter, r *http.Request)
+	UncheckChecklistItem(w http.ResponseWriter, r *http.Request)
+	ReorderChecklist(w http.ResponseWriter, r *http.Request)
+	DeleteChecklistItem(w http.ResponseWriter, r *http.Request)
+	GetRecurrence(w http.ResponseWriter, r *http.Request)
+	UpdateRecurrence(w http.ResponseWriter, r *http.Request)
+	StopRecurrence(w http.ResponseWriter, r *http.Request)
+	AddDependency(w http.ResponseWriter, r *http.Request)
+	RemoveDependency(w http.ResponseWriter, r *http.Request)
+	ShareTask(w http.ResponseWriter, r *http.Request)
+	GetTaskShares(w http.ResponseWriter, r *http.Request)
+	RemoveTaskShare(w http.ResponseWriter, r *http.Request)
+	AddComment(w http.ResponseWriter, r *http.Request)
+	GetCommentList(w http.ResponseWriter, r *http.Request)
+	UpdateComment(w http.ResponseWriter, r *http.Request)
+	DeleteComment(w http.ResponseWriter, r *http.Request)
+	AddAttachment(w http.ResponseWriter, r *http.Request)
+	GetAttachmentList(w http.ResponseWriter, r *http.Request)
+	DownloadAttachment(w http.ResponseWriter, r *http.Request)
+	DeleteAttachment(w http.ResponseWriter, r *http.Request)
+	GetTaskHistory(w http.ResponseWriter, r *http.Request)
+	StartTask(w http.ResponseWriter, r *http.Request)
+	CancelTask(w http.ResponseWriter, r *http.Request)
+	ReopenTask(w http.ResponseWriter, r *http.Request)
+	BulkTask(w http.ResponseWriter, r *http.Request)
+	StartTimer(w http.ResponseWriter, r *http.Request)
+	StopTimer(w http.ResponseWriter, r *http.Request)
+	GetTimeReport(w http.ResponseWriter, r *http.Request)
+	SnoozeTask(w http.ResponseWriter, r *http.Request)
+	MoveTask(w http.ResponseWriter, r *http.Request)
 }
 
 // TaskHandler adalah implementasi dari TaskHandlerInterface
@@ -51,13 +93,12 @@ func (h *TaskHandler) extractBearerToken(r *http.Request) (string, error) {
 	return parts[1], nil // Mengembalikan token tanpa kata "Bearer"
 }
 
-// AddTask menangani request untuk menambahkan task baru
-func (h *TaskHandler) AddTask(w http.ResponseWriter, r *http.Request) {
+// authorize memverifikasi token JWT dan mengembalikan klaim milik user
+func (h *TaskHandler) authorize(r *http.Request) (*helper.TokenClaims, error) {
 	// Ekstrak token dari header Authorization
 	tokenString, err := h.extractBearerToken(r)
 	if err != nil {
-		h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, err))
-		return
+		return nil, common_error.NewError(common_error.UNAUTHORIZED, err)
 	}
 
 	// Verifikasi token JWT
@@ -65,17 +106,48 @@ func (h *TaskHandler) AddTask(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		// Tangani error jika token tidak valid atau kadaluarsa
 		if err == jwt.ErrSignatureInvalid || strings.Contains(err.Error(), "token is expired") {
-			h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, errors.New("token expired")))
-			return
+			return nil, common_error.NewError(common_error.UNAUTHORIZED, errors.New("token expired"))
 		}
-		h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, err))
+		return nil, common_error.NewError(common_error.UNAUTHORIZED, err)
+	}
+
+	return dataClaims, nil
+}
+
+// taskID mengambil id task dari parameter URL
+func (h *TaskHandler) taskID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || id <= 0 {
+		return 0, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid task id"))
+	}
+	return id, nil
+}
+
+// audit mengambil pelaku dan X-Request-ID request untuk dicatat di riwayat task.
+// X-Request-ID dari client dipotong agar muat di kolom request_id.
+func (h *TaskHandler) audit(r *http.Request, userID int64) dto.AuditDTO {
+	requestID := middleware.GetReqID(r.Context())
+	if len(requestID) > dto.MaxRequestIDLength {
+		requestID = requestID[:dto.MaxRequestIDLength]
+	}
+
+	return dto.AuditDTO{
+		ActorID:   userID,
+		RequestID: requestID,
+	}
+}
+
+// AddTask menangani request untuk menambahkan task baru
+func (h *TaskHandler) AddTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
 		return
 	}
 
 	// Inisialisasi DTO untuk task baru
-	postDTO := dto.CreateTaskReqDTO{
-		UserID: dataClaims.UserID, // Ambil UserID dari token yang telah diverifikasi
-	}
+	postDTO := dto.CreateTaskReqDTO{}
 
 	// Decode body request ke DTO
 	err = json.NewDecoder(r.Body).Decode(&postDTO)
@@ -84,6 +156,10 @@ func (h *TaskHandler) AddTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Ambil UserID dari token yang telah diverifikasi, bukan dari body request
+	postDTO.UserID = dataClaims.UserID
+	postDTO.Audit = h.audit(r, dataClaims.UserID)
+
 	// Validasi input data task
 	err = postDTO.Validate()
 	if err != nil {
@@ -109,22 +185,10 @@ func (h *TaskHandler) AddTask(w http.ResponseWriter, r *http.Request) {
 
 // FinishTask menangani request untuk menyelesaikan task
 func (h *TaskHandler) FinishTask(w http.ResponseWriter, r *http.Request) {
-	// Ekstrak token dari header Authorization
-	tokenString, err := h.extractBearerToken(r)
-	if err != nil {
-		h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, err))
-		return
-	}
-
-	// Verifikasi token JWT
-	_, err = helper.VerifyToken(tokenString)
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
 	if err != nil {
-		// Tangani error jika token tidak valid atau kadaluarsa
-		if err == jwt.ErrSignatureInvalid || strings.Contains(err.Error(), "token is expired") {
-			h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, errors.New("token expired")))
-			return
-		}
-		h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, err))
+		h.response.HttpError(w, err)
 		return
 	}
 
@@ -138,6 +202,17 @@ func (h *TaskHandler) FinishTask(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// UserID selalu diambil dari token, bukan dari body request
+	postDTO.UserID = dataClaims.UserID
+	postDTO.Audit = h.audit(r, dataClaims.UserID)
+
+	// Validasi input data task
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
 	// Panggil use case untuk menyelesaikan task
 	err = h.usecase.FinishTask(&postDTO)
 	if err != nil {
@@ -154,30 +229,81 @@ func (h *TaskHandler) FinishTask(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// UpdateTask menangani request untuk memperbarui title dan expires_at task
+func (h *TaskHandler) UpdateTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO untuk memperbarui task
+	putDTO := dto.UpdateTaskReqDTO{}
+
+	// Decode body request ke DTO
+	err = json.NewDecoder(r.Body).Decode(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	putDTO.ID = id
+	putDTO.UserID = dataClaims.UserID
+	putDTO.Audit = h.audit(r, dataClaims.UserID)
+
+	// Validasi input data task
+	err = putDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk memperbarui task
+	resp, err := h.usecase.UpdateTask(&putDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data task terbaru
+	h.response.JSON(
+		w,
+		"update task sukses",
+		resp,
+		nil,
+	)
+}
+
 // GetTaskList menangani request untuk mendapatkan daftar task pengguna
 func (h *TaskHandler) GetTaskList(w http.ResponseWriter, r *http.Request) {
-	// Ekstrak token dari header Authorization
-	tokenString, err := h.extractBearerToken(r)
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
 	if err != nil {
-		h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, err))
+		h.response.HttpError(w, err)
 		return
 	}
 
-	// Verifikasi token JWT
-	dataClaims, err := helper.VerifyToken(tokenString)
+	// Inisialisasi DTO untuk mendapatkan task dari query parameter
+	getDTO, err := h.taskListQuery(r)
 	if err != nil {
-		// Tangani error jika token tidak valid atau kadaluarsa
-		if err == jwt.ErrSignatureInvalid || strings.Contains(err.Error(), "token is expired") {
-			h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, errors.New("token expired")))
-			return
-		}
-		h.response.HttpError(w, common_error.NewError(common_error.UNAUTHORIZED, err))
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
 		return
 	}
+	getDTO.UserID = dataClaims.UserID // Ambil UserID dari token
 
-	// Inisialisasi DTO untuk mendapatkan task
-	getDTO := dto.GetTaskReqDTO{
-		UserID: dataClaims.UserID, // Ambil UserID dari token
+	// Validasi filter daftar task
+	err = getDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
 	}
 
 	// Panggil use case untuk mendapatkan daftar task
@@ -187,10 +313,245 @@ func (h *TaskHandler) GetTaskList(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Beri response sukses dengan daftar task
+	meta := h.response.BuildMeta(int(getDTO.Page), int(getDTO.PerPage), resp.Total)
+	meta.NextCursor = resp.NextCursor
+
+	// Beri response sukses dengan daftar task dan informasi paginasi
 	h.response.JSON(
 		w,
 		"get data task sukses",
+		resp.Tasks,
+		meta,
+	)
+}
+
+// GetProjectTaskList menangani request untuk mendapatkan daftar task di dalam satu project.
+// Filter dan paginasi sama dengan GetTaskList, id project diambil dari URL.
+func (h *TaskHandler) GetProjectTaskList(w http.ResponseWriter, r *http.Request) {
+	projectID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil || projectID <= 0 {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("invalid project id")))
+		return
+	}
+
+	query := r.URL.Query()
+	query.Set("project_id", strconv.FormatInt(projectID, 10))
+	r.URL.RawQuery = query.Encode()
+
+	h.GetTaskList(w, r)
+}
+
+// SearchTask menangani request untuk mencari task berdasarkan title dan description
+func (h *TaskHandler) SearchTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO untuk pencarian dari query parameter
+	query := r.URL.Query()
+	getDTO := dto.SearchTaskReqDTO{
+		UserID: dataClaims.UserID, // Ambil UserID dari token
+		Query:  strings.TrimSpace(query.Get("q")),
+	}
+
+	if v := query.Get("page"); v != "" {
+		getDTO.Page, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("page: must be a number")))
+			return
+		}
+	}
+
+	if v := query.Get("per_page"); v != "" {
+		getDTO.PerPage, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("per_page: must be a number")))
+			return
+		}
+	}
+
+	// Validasi kata kunci pencarian
+	err = getDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk mencari task
+	resp, total, err := h.usecase.SearchTask(&getDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan hasil pencarian
+	h.response.JSON(
+		w,
+		"search task sukses",
+		resp,
+		h.response.BuildMeta(int(getDTO.Page), int(getDTO.PerPage), total),
+	)
+}
+
+// taskListQuery membaca filter, urutan, dan paginasi daftar task dari query parameter
+func (h *TaskHandler) taskListQuery(r *http.Request) (dto.GetTaskReqDTO, error) {
+	query := r.URL.Query()
+	req := dto.GetTaskReqDTO{
+		Status:   query.Get("status"),
+		Priority: query["priority"],
+		Tags:     query["tag"],
+		TagMode:  strings.ToLower(query.Get("tag_mode")),
+		Sort:     query.Get("sort"),
+		Order:    strings.ToLower(query.Get("order")),
+	}
+
+	if v := query.Get("project_id"); v != "" {
+		projectID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return req, errors.New("project_id: must be a number")
+		}
+		req.ProjectID = &projectID
+	}
+
+	if v := query.Get("expires_before"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return req, errors.New("expires_before: must be RFC3339 time")
+		}
+		req.ExpiresBefore = &t
+	}
+
+	if v := query.Get("expires_after"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return req, errors.New("expires_after: must be RFC3339 time")
+		}
+		req.ExpiresAfter = &t
+	}
+
+	if v := query.Get("min_snooze_count"); v != "" {
+		minSnooze, err := strconv.Atoi(v)
+		if err != nil {
+			return req, errors.New("min_snooze_count: must be a number")
+		}
+		req.MinSnooze = &minSnooze
+	}
+
+	if v := query.Get("cursor"); v != "" {
+		expiresAt, id, err := helper.DecodeCursor(v)
+		if err != nil {
+			return req, errors.New("cursor: invalid value")
+		}
+		req.Cursor = v
+		req.After = &dto.TaskCursor{ExpiresAt: expiresAt, ID: id}
+	}
+
+	if v := query.Get("page"); v != "" {
+		page, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return req, errors.New("page: must be a number")
+		}
+		req.Page = page
+	}
+
+	if v := query.Get("per_page"); v != "" {
+		perPage, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return req, errors.New("per_page: must be a number")
+		}
+		req.PerPage = perPage
+	}
+
+	return req, nil
+}
+
+// DeleteTask menangani request untuk memindahkan task ke trash
+func (h *TaskHandler) DeleteTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk menghapus task
+	err = h.usecase.DeleteTask(&dto.DeleteTaskReqDTO{ID: id, UserID: dataClaims.UserID, Audit: h.audit(r, dataClaims.UserID)})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses
+	h.response.JSON(
+		w,
+		"task dipindahkan ke trash",
+		nil,
+		nil,
+	)
+}
+
+// RestoreTask menangani request untuk mengembalikan task dari trash
+func (h *TaskHandler) RestoreTask(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	id, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mengembalikan task
+	resp, err := h.usecase.RestoreTask(&dto.RestoreTaskReqDTO{ID: id, UserID: dataClaims.UserID, Audit: h.audit(r, dataClaims.UserID)})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan data task
+	h.response.JSON(
+		w,
+		"restore task sukses",
+		resp,
+		nil,
+	)
+}
+
+// GetTrashList menangani request untuk mendapatkan daftar task di trash
+func (h *TaskHandler) GetTrashList(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Panggil use case untuk mendapatkan daftar task di trash
+	resp, err := h.usecase.GetTrashList(&dto.GetTaskReqDTO{UserID: dataClaims.UserID})
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan daftar task
+	h.response.JSON(
+		w,
+		"get data trash sukses",
 		resp,
 		nil,
 	)
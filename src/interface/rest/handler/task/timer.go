@@ -0,0 +1,122 @@
+package task
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+	dto "todo_list/src/app/dto/task"
+	common_error "todo_list/src/infra/errors"
+)
+
+// StartTimer menangani request untuk memulai timer pada task
+func (h *TaskHandler) StartTimer(w http.ResponseWriter, r *http.Request) {
+	h.timer(w, r, h.usecase.StartTimer, "start timer sukses")
+}
+
+// StopTimer menangani request untuk menghentikan timer pada task
+func (h *TaskHandler) StopTimer(w http.ResponseWriter, r *http.Request) {
+	h.timer(w, r, h.usecase.StopTimer, "stop timer sukses")
+}
+
+// timer menjalankan start atau stop timer, body request dengan note bersifat opsional
+func (h *TaskHandler) timer(w http.ResponseWriter, r *http.Request, run func(*dto.TimerReqDTO) (*dto.TimeEntryRespDTO, error), message string) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Ambil id task dari URL
+	taskID, err := h.taskID(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Decode body request ke DTO, body kosong diperbolehkan
+	postDTO := dto.TimerReqDTO{}
+	err = json.NewDecoder(r.Body).Decode(&postDTO)
+	if err != nil && !errors.Is(err, io.EOF) {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	postDTO.TaskID = taskID
+	postDTO.UserID = dataClaims.UserID
+
+	// Validasi catatan timer
+	err = postDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	resp, err := run(&postDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan catatan waktu
+	h.response.JSON(
+		w,
+		message,
+		resp,
+		nil,
+	)
+}
+
+// GetTimeReport menangani request untuk mendapatkan laporan waktu user per hari
+func (h *TaskHandler) GetTimeReport(w http.ResponseWriter, r *http.Request) {
+	// Verifikasi token JWT dari header Authorization
+	dataClaims, err := h.authorize(r)
+	if err != nil {
+		h.response.HttpError(w, err)
+		return
+	}
+
+	// Inisialisasi DTO rentang tanggal dari query parameter
+	query := r.URL.Query()
+	getDTO := dto.GetTimeReportReqDTO{UserID: dataClaims.UserID}
+
+	if v := query.Get("from"); v != "" {
+		getDTO.From, err = time.Parse(dto.DateLayout, v)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("from: must be a date in YYYY-MM-DD format")))
+			return
+		}
+	}
+
+	if v := query.Get("to"); v != "" {
+		getDTO.To, err = time.Parse(dto.DateLayout, v)
+		if err != nil {
+			h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, errors.New("to: must be a date in YYYY-MM-DD format")))
+			return
+		}
+	}
+
+	// Validasi rentang tanggal
+	err = getDTO.Validate()
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.DATA_INVALID, err))
+		return
+	}
+
+	// Panggil use case untuk mendapatkan laporan waktu
+	resp, err := h.usecase.GetTimeReport(&getDTO)
+	if err != nil {
+		h.response.HttpError(w, common_error.NewError(common_error.UNKNOWN_ERROR, err))
+		return
+	}
+
+	// Beri response sukses dengan laporan waktu
+	h.response.JSON(
+		w,
+		"get time report sukses",
+		resp,
+		nil,
+	)
+}
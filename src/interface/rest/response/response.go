@@ -18,10 +18,16 @@ type HealthCheckMessage struct {
 }
 
 // Meta consist of pagination details
+// Total -> total pages
+// TotalData -> total rows matching the request
+// NextCursor -> opaque cursor for the next page (keyset pagination)
 type Meta struct {
-	Skip  int     `json:"skip,omitempty"`
-	Limit int     `json:"limit,omitempty"`
-	Total float64 `json:"total,omitempty"`
+	Page       int     `json:"page,omitempty"`
+	Skip       int     `json:"skip,omitempty"`
+	Limit      int     `json:"limit,omitempty"`
+	Total      float64 `json:"total,omitempty"`
+	TotalData  int64   `json:"total_data"`
+	NextCursor string  `json:"next_cursor,omitempty"`
 }
 
 // ResponseMessage consist of payload details
@@ -101,10 +107,12 @@ func (r *responseClient) HttpError(
 	}
 }
 
-func (r *responseClient) BuildMeta(skip int, limit int, count int64) *Meta {
+func (r *responseClient) BuildMeta(page int, perPage int, count int64) *Meta {
 	return &Meta{
-		Skip:  skip,
-		Limit: limit,
-		Total: math.Ceil(float64(count) / float64(limit)),
+		Page:      page,
+		Skip:      (page - 1) * perPage,
+		Limit:     perPage,
+		Total:     math.Ceil(float64(count) / float64(perPage)),
+		TotalData: count,
 	}
 }
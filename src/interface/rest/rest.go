@@ -11,6 +11,8 @@ import (
 	usecases "todo_list/src/app/usecases"
 	"todo_list/src/infra/config"
 
+	projectHandler "todo_list/src/interface/rest/handler/project"
+	tagHandler "todo_list/src/interface/rest/handler/tag"
 	taskHandler "todo_list/src/interface/rest/handler/task"
 	userHandler "todo_list/src/interface/rest/handler/user"
 	"todo_list/src/interface/rest/response"
@@ -73,8 +75,9 @@ func makeRoute(
 
 	corsMiddleware := cors.New(cors.Options{
 		AllowedOrigins:   []string{"*"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE"},
-		AllowedHeaders:   []string{"Accept", "Content-Type", "Authorization"},
+		AllowedMethods:   []string{"GET", "POST", "PUT", "PATCH", "DELETE"},
+		AllowedHeaders:   []string{"Accept", "Content-Type", "Authorization", xRequestID},
+		ExposedHeaders:   []string{xRequestID},
 		AllowCredentials: true,
 		MaxAge:           300,
 	})
@@ -86,6 +89,17 @@ func makeRoute(
 		logger.Fatalf("invalid x-request-id")
 	}
 
+	// Gunakan X-Request-ID dari client jika ada, lalu kembalikan di response
+	// agar request bisa dicocokkan dengan riwayat task dan log consumer
+	middleware.RequestIDHeader = xRequestID
+	r.Use(middleware.RequestID)
+	r.Use(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(xRequestID, middleware.GetReqID(r.Context()))
+			next.ServeHTTP(w, r)
+		})
+	})
+
 	// timeout middleware
 	if timeout <= 0 {
 		logger.Fatalf("invalid http timeout")
@@ -97,9 +111,13 @@ func makeRoute(
 
 	uh := userHandler.NewUserHandler(respClient, useCases.UserUC)
 	th := taskHandler.NewTaskHandler(respClient, useCases.TaskUC)
+	tgh := tagHandler.NewTagHandler(respClient, useCases.TagUC)
+	ph := projectHandler.NewProjectHandler(respClient, useCases.ProjectUC)
 	r.Route("/api", func(r chi.Router) {
 		r.Mount("/user", route.UserRouter(uh))
 		r.Mount("/task", route.TaskRouter(th))
+		r.Mount("/tag", route.TagRouter(tgh))
+		r.Mount("/project", route.ProjectRouter(ph, th))
 
 	})
 	return r
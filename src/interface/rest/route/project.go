@@ -0,0 +1,31 @@
+package route
+
+import (
+	"net/http"
+
+	handlers "todo_list/src/interface/rest/handler/project"
+	taskHandlers "todo_list/src/interface/rest/handler/task"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// ProjectRouter router untuk pengelolaan project dan daftar task di dalamnya
+func ProjectRouter(h handlers.ProjectHandlerInterface, th taskHandlers.TaskHandlerInterface) http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/", h.GetProjectList)
+	r.Post("/", h.AddProject)
+	r.Put("/order", h.ReorderProject)
+	r.Get("/{id}", h.GetProject)
+	r.Put("/{id}", h.UpdateProject)
+	r.Patch("/{id}", h.UpdateProject)
+	r.Delete("/{id}", h.DeleteProject)
+	r.Get("/{id}/tasks", th.GetProjectTaskList)
+
+	// Kolaborator project, berlaku untuk semua task di dalam project
+	r.Get("/{id}/shares", h.GetProjectShares)
+	r.Post("/{id}/shares", h.ShareProject)
+	r.Delete("/{id}/shares/{userId}", h.RemoveProjectShare)
+
+	return r
+}
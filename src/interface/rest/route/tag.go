@@ -0,0 +1,22 @@
+package route
+
+import (
+	"net/http"
+
+	handlers "todo_list/src/interface/rest/handler/tag"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// TagRouter router untuk pengelolaan tag milik user
+func TagRouter(h handlers.TagHandlerInterface) http.Handler {
+	r := chi.NewRouter()
+
+	r.Get("/", h.GetTagList)
+	r.Post("/", h.AddTag)
+	r.Put("/{id}", h.UpdateTag)
+	r.Patch("/{id}", h.UpdateTag)
+	r.Delete("/{id}", h.DeleteTag)
+
+	return r
+}
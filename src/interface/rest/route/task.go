@@ -15,6 +15,65 @@ func TaskRouter(h handlers.TaskHandlerInterface) http.Handler {
 	r.Post("/", h.AddTask)
 	r.Patch("/", h.FinishTask)
 	r.Get("/", h.GetTaskList)
+	r.Get("/search", h.SearchTask)
+	r.Get("/trash", h.GetTrashList)
+	r.Post("/bulk", h.BulkTask)
+	r.Get("/time-report", h.GetTimeReport)
+	r.Put("/{id}", h.UpdateTask)
+	r.Patch("/{id}", h.UpdateTask)
+	r.Delete("/{id}", h.DeleteTask)
+	r.Post("/{id}/restore", h.RestoreTask)
+
+	// Perpindahan status task
+	r.Post("/{id}/start", h.StartTask)
+	r.Post("/{id}/cancel", h.CancelTask)
+	r.Post("/{id}/reopen", h.ReopenTask)
+	r.Post("/{id}/snooze", h.SnoozeTask)
+
+	// Urutan manual task
+	r.Post("/{id}/move", h.MoveTask)
+
+	// Checklist item di bawah task
+	r.Get("/{id}/items", h.GetChecklist)
+	r.Post("/{id}/items", h.AddChecklistItem)
+	r.Put("/{id}/items/order", h.ReorderChecklist)
+	r.Post("/{id}/items/{itemId}/check", h.CheckChecklistItem)
+	r.Post("/{id}/items/{itemId}/uncheck", h.UncheckChecklistItem)
+	r.Delete("/{id}/items/{itemId}", h.DeleteChecklistItem)
+
+	// Seri berulang dari task
+	r.Get("/{id}/recurrence", h.GetRecurrence)
+	r.Put("/{id}/recurrence", h.UpdateRecurrence)
+	r.Delete("/{id}/recurrence", h.StopRecurrence)
+
+	// Ketergantungan task, task tidak bisa diselesaikan sebelum dependency selesai
+	r.Post("/{id}/dependencies", h.AddDependency)
+	r.Delete("/{id}/dependencies/{dependsOnId}", h.RemoveDependency)
+
+	// Kolaborator task
+	r.Get("/{id}/shares", h.GetTaskShares)
+	r.Post("/{id}/shares", h.ShareTask)
+	r.Delete("/{id}/shares/{userId}", h.RemoveTaskShare)
+
+	// Komentar pada task
+	r.Get("/{id}/comments", h.GetCommentList)
+	r.Post("/{id}/comments", h.AddComment)
+	r.Put("/{id}/comments/{commentId}", h.UpdateComment)
+	r.Patch("/{id}/comments/{commentId}", h.UpdateComment)
+	r.Delete("/{id}/comments/{commentId}", h.DeleteComment)
+
+	// Lampiran file pada task
+	r.Get("/{id}/attachments", h.GetAttachmentList)
+	r.Post("/{id}/attachments", h.AddAttachment)
+	r.Get("/{id}/attachments/{attachmentId}", h.DownloadAttachment)
+	r.Delete("/{id}/attachments/{attachmentId}", h.DeleteAttachment)
+
+	// Riwayat perubahan task
+	r.Get("/{id}/history", h.GetTaskHistory)
+
+	// Timer pencatatan waktu, satu user hanya boleh memiliki satu timer berjalan
+	r.Post("/{id}/timer/start", h.StartTimer)
+	r.Post("/{id}/timer/stop", h.StopTimer)
 
 	return r
 }
@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"time"
+
+	usecases "todo_list/src/app/usecases"
+	"todo_list/src/infra/config"
+	"todo_list/src/infra/scheduler"
+
+	"github.com/sirupsen/logrus"
+)
+
+// New membuat scheduler dan mendaftarkan semua pekerjaan berkala.
+// Sweeper kadaluarsa hanya didaftarkan jika Redis tidak tersedia,
+// karena dalam kondisi itu kadaluarsa task tidak dipicu oleh TTL Redis.
+func New(
+	conf config.SchedulerConf,
+	redisActive bool,
+	logger *logrus.Logger,
+	useCases usecases.AllUseCases,
+) *scheduler.Scheduler {
+	s := scheduler.New(logger)
+
+	if !redisActive {
+		s.Every(
+			"expiry-sweeper",
+			time.Duration(conf.ExpirySweepInterval)*time.Second,
+			expirySweeper(conf.ExpirySweepBatchSize, useCases),
+		)
+	}
+
+	s.Every(
+		"trash-purge",
+		time.Duration(conf.TrashPurgeInterval)*time.Second,
+		trashPurge(time.Duration(conf.TrashRetentionDays)*24*time.Hour, logger, useCases),
+	)
+
+	s.Every(
+		"reminder-dispatcher",
+		time.Duration(conf.ReminderInterval)*time.Second,
+		reminderDispatcher(conf.ReminderBatchSize, useCases),
+	)
+
+	return s
+}
+
+// expirySweeper memproses task yang lewat waktu per batch sampai habis
+func expirySweeper(batchSize int, useCases usecases.AllUseCases) scheduler.JobFunc {
+	return func() error {
+		for {
+			total, err := useCases.TaskUC.ExpireOverdueTasks(batchSize)
+			if err != nil {
+				return err
+			}
+			if total < batchSize {
+				return nil
+			}
+		}
+	}
+}
+
+// trashPurge menghapus permanen task yang sudah melewati masa retensi trash
+func trashPurge(retention time.Duration, logger *logrus.Logger, useCases usecases.AllUseCases) scheduler.JobFunc {
+	return func() error {
+		total, err := useCases.TaskUC.PurgeTrash(retention)
+		if err != nil {
+			return err
+		}
+		if total > 0 {
+			logger.Printf("purged %d task(s) from trash", total)
+		}
+		return nil
+	}
+}
+
+// reminderDispatcher mengirimkan pengingat yang jatuh tempo per batch sampai habis
+func reminderDispatcher(batchSize int, useCases usecases.AllUseCases) scheduler.JobFunc {
+	return func() error {
+		for {
+			total, err := useCases.TaskUC.SendDueReminders(batchSize)
+			if err != nil {
+				return err
+			}
+			if total < batchSize {
+				return nil
+			}
+		}
+	}
+}